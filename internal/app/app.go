@@ -75,16 +75,26 @@ func Run() {
 
 	ctrl.Stop(ctx)
 
+	// wait for the running tasks, the scheduled jobs are persisted and restored on the next start
+	if err := w.Stop(ctx); err != nil {
+		log.Error().Err(err).Msg("Stopping workers failed")
+	}
 }
 
 func InitWorkers(u *useCase.UseCase) error {
 	// Initialize the application workers
 	ctx := context.Background()
-	// create the teams challenges for already started events
-	if err := u.CreateEventTeamsChallengesTasks(ctx); err != nil {
+	// queue the jobs scheduled before the restart
+	if err := u.RestoreJobs(ctx); err != nil {
+		return err
+	}
+	log.Info().Msg("All pending jobs are restored")
+
+	// schedule the missing jobs of the events, it also creates the teams challenges for already started events
+	if err := u.ScheduleEventsJobs(ctx); err != nil {
 		return err
 	}
-	log.Info().Msg("All challenges added to already started events")
+	log.Info().Msg("All events jobs are scheduled")
 
 	log.Info().Msg("Application workers are initialized")
 	return nil
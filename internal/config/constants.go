@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 const (
 	//MigrationPath     = "internal/delivery/repository/postgres/migrations"
 	MigrationPath = "migrations"
@@ -11,6 +13,29 @@ const (
 	RandomFlagLength = 20
 )
 
+// laboratory
+const (
+	LaboratoryCIDRMask = 26
+
+	// ChallengeProvisionLeadTime is how long before the challenge release its exercise is deployed
+	ChallengeProvisionLeadTime = 5 * time.Minute
+)
+
+// jobs
+const (
+	JobMaxAttempts    = 5
+	JobRetryBaseDelay = 30 * time.Second
+	JobRetryMaxDelay  = 30 * time.Minute
+	JobTimeout        = 10 * time.Minute
+)
+
+// score feed
+const (
+	// ScoreFeedHeartbeatInterval is how often the heartbeat is sent to the idle score stream,
+	// so the proxies and the server write timeout do not close it
+	ScoreFeedHeartbeatInterval = 15 * time.Second
+)
+
 // subdomains and paths
 const (
 	MainSubdomain    = ""
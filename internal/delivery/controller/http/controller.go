@@ -7,6 +7,7 @@ import (
 	"github.com/cybericebox/daemon/internal/delivery/controller/http/handler"
 	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
 	"github.com/cybericebox/daemon/internal/delivery/controller/http/proxy"
+	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gin-gonic/gin"
 )
 
@@ -24,6 +25,7 @@ type (
 		protection.IUseCase
 
 		URLNeedsProtection(ctx context.Context, url string) bool
+		EventFrontendNeedsProtection(ctx context.Context, subdomain string) bool
 	}
 
 	Dependencies struct {
@@ -56,7 +58,8 @@ func NewController(deps Dependencies) *Controller {
 
 	// frontends that need protection
 	protectFrontends := func(ctx *gin.Context) bool {
-		return deps.UseCase.URLNeedsProtection(ctx, ctx.Request.URL.Path)
+		return deps.UseCase.URLNeedsProtection(ctx, ctx.Request.URL.Path) ||
+			deps.UseCase.EventFrontendNeedsProtection(ctx, ctx.GetString(tools.SubdomainCtxKey))
 	}
 
 	//proxy to frontends
@@ -8,6 +8,9 @@ import (
 	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"math"
+	"strconv"
+	"time"
 )
 
 type IChallengeUseCase interface {
@@ -15,11 +18,20 @@ type IChallengeUseCase interface {
 	GetEventChallengesInfo(ctx context.Context, eventID uuid.UUID) ([]*model.CategoryInfo, error)
 	AddExercisesToEvent(ctx context.Context, eventID, categoryID uuid.UUID, exerciseIDs []uuid.UUID) error
 	DeleteEventChallenge(ctx context.Context, eventID uuid.UUID, challengeID uuid.UUID) error
+	ReconcileEventChallenges(ctx context.Context, eventID uuid.UUID) error
 
 	UpdateEventChallengesOrder(ctx context.Context, eventID uuid.UUID, orders []model.Order) error
+	UpdateEventChallengeReleaseTime(ctx context.Context, eventID, challengeID uuid.UUID, releaseTime *time.Time) error
+	UpdateEventChallengeVisibility(ctx context.Context, eventID, challengeID uuid.UUID, visibility int32) error
+	UpdateEventChallengeScoring(ctx context.Context, challenge *model.Challenge) error
 
 	GetTeamsSolvedChallenge(ctx context.Context, eventID, challengeID uuid.UUID) ([]*model.TeamSolvedChallenge, error)
-	SolveChallenge(ctx context.Context, eventID, challengeID uuid.UUID, solution string) (bool, error)
+	SolveChallenge(ctx context.Context, eventID, challengeID uuid.UUID, solution string) (*model.SolutionAttemptResult, error)
+
+	GetEventChallengePrerequisites(ctx context.Context, eventID, challengeID uuid.UUID) ([]*model.ChallengePrerequisite, error)
+	UpdateEventChallengePrerequisites(ctx context.Context, eventID, challengeID uuid.UUID, prerequisites []*model.ChallengePrerequisite) error
+
+	UnlockChallengeHint(ctx context.Context, eventID, challengeID, hintID uuid.UUID) (*model.HintInfo, error)
 }
 
 func (h *Handler) initChallengeAPIHandler(router *gin.RouterGroup) {
@@ -30,12 +42,21 @@ func (h *Handler) initChallengeAPIHandler(router *gin.RouterGroup) {
 		challengeAPI.POST("", h.addExerciseToEvent)   // add all challenges from exercise to event
 
 		challengeAPI.PATCH("order", h.updateChallengesOrder)
+		challengeAPI.POST("reconcile", h.reconcileChallenges) // reconcile challenges of all teams
 
 		singleChallengeAPI := challengeAPI.Group(":challengeID")
 		{
-			singleChallengeAPI.DELETE("", h.deleteChallenge)           // delete challenge
-			singleChallengeAPI.POST("solve", h.solveChallenge)         // solve challenge
-			singleChallengeAPI.GET("solvedBy", h.getChallengeSolvedBy) // get teams solved challenge
+			singleChallengeAPI.DELETE("", h.deleteChallenge)                    // delete challenge
+			singleChallengeAPI.PATCH("release", h.updateChallengeRelease)       // update challenge release time
+			singleChallengeAPI.PATCH("visibility", h.updateChallengeVisibility) // update challenge visibility
+			singleChallengeAPI.PATCH("scoring", h.updateChallengeScoring)       // update challenge dynamic scoring overrides
+			singleChallengeAPI.POST("solve", h.solveChallenge)                  // solve challenge
+			singleChallengeAPI.GET("solvedBy", h.getChallengeSolvedBy)          // get teams solved challenge
+
+			singleChallengeAPI.GET("prerequisites", h.getChallengePrerequisites)    // get challenge prerequisites
+			singleChallengeAPI.PUT("prerequisites", h.updateChallengePrerequisites) // replace challenge prerequisites
+
+			singleChallengeAPI.POST("hints/:hintID/unlock", h.unlockChallengeHint) // unlock challenge hint for the team
 		}
 
 		h.initChallengeCategoryAPIHandler(challengeAPI)
@@ -103,6 +124,17 @@ func (h *Handler) updateChallengesOrder(ctx *gin.Context) {
 	response.AbortWithOK(ctx, "Challenges order updated successfully")
 }
 
+func (h *Handler) reconcileChallenges(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	if err := h.useCase.ReconcileEventChallenges(ctx, eventID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Challenges reconciled successfully")
+}
+
 func (h *Handler) deleteChallenge(ctx *gin.Context) {
 	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
 	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
@@ -114,6 +146,80 @@ func (h *Handler) deleteChallenge(ctx *gin.Context) {
 	response.AbortWithOK(ctx, "Challenge deleted successfully")
 }
 
+type updateChallengeReleaseInput struct {
+	ReleaseTime *time.Time
+}
+
+func (h *Handler) updateChallengeRelease(ctx *gin.Context) {
+	var inp updateChallengeReleaseInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	if err := h.useCase.UpdateEventChallengeReleaseTime(ctx, eventID, challengeID, inp.ReleaseTime); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Challenge release time updated successfully")
+}
+
+type updateVisibilityInput struct {
+	Visibility int32
+}
+
+func (h *Handler) updateChallengeVisibility(ctx *gin.Context) {
+	var inp updateVisibilityInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	if err := h.useCase.UpdateEventChallengeVisibility(ctx, eventID, challengeID, inp.Visibility); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Challenge visibility updated successfully")
+}
+
+type updateChallengeScoringInput struct {
+	DynamicMaxScore       *int32
+	DynamicMinScore       *int32
+	DynamicSolveThreshold *int32
+}
+
+func (h *Handler) updateChallengeScoring(ctx *gin.Context) {
+	var inp updateChallengeScoringInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	if err := h.useCase.UpdateEventChallengeScoring(ctx, &model.Challenge{
+		ID:                    challengeID,
+		EventID:               eventID,
+		DynamicMaxScore:       inp.DynamicMaxScore,
+		DynamicMinScore:       inp.DynamicMinScore,
+		DynamicSolveThreshold: inp.DynamicSolveThreshold,
+	}); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Challenge scoring updated successfully")
+}
+
 type solveChallengeRequest struct {
 	Solution string
 }
@@ -128,12 +234,15 @@ func (h *Handler) solveChallenge(ctx *gin.Context) {
 	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
 	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
 
-	solved, err := h.useCase.SolveChallenge(ctx, eventID, challengeID, req.Solution)
+	result, err := h.useCase.SolveChallenge(ctx, eventID, challengeID, req.Solution)
+	if result != nil {
+		setSolutionAttemptHeaders(ctx, result)
+	}
 	if err != nil {
 		response.AbortWithError(ctx, err)
 		return
 	}
-	if !solved {
+	if !result.Solved {
 		response.AbortWithBadRequest(ctx, model.ErrIncorrectSolution)
 		return
 	}
@@ -141,6 +250,17 @@ func (h *Handler) solveChallenge(ctx *gin.Context) {
 	response.AbortWithOK(ctx, "Challenge solved successfully")
 }
 
+// setSolutionAttemptHeaders informs the team about the attempts left and when it can try again
+func setSolutionAttemptHeaders(ctx *gin.Context, result *model.SolutionAttemptResult) {
+	if result.RemainingAttempts >= 0 {
+		ctx.Header("X-Attempts-Remaining", strconv.Itoa(int(result.RemainingAttempts)))
+	}
+
+	if result.RetryAfter != nil {
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(time.Until(*result.RetryAfter).Seconds()))))
+	}
+}
+
 func (h *Handler) getChallengeSolvedBy(ctx *gin.Context) {
 	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
 	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
@@ -153,3 +273,48 @@ func (h *Handler) getChallengeSolvedBy(ctx *gin.Context) {
 
 	response.AbortWithContent(ctx, teams)
 }
+
+func (h *Handler) unlockChallengeHint(ctx *gin.Context) {
+	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
+	hintID := uuid.FromStringOrNil(ctx.Param("hintID"))
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	hint, err := h.useCase.UnlockChallengeHint(ctx, eventID, challengeID, hintID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithContent(ctx, hint)
+}
+
+func (h *Handler) getChallengePrerequisites(ctx *gin.Context) {
+	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	prerequisites, err := h.useCase.GetEventChallengePrerequisites(ctx, eventID, challengeID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithContent(ctx, prerequisites)
+}
+
+func (h *Handler) updateChallengePrerequisites(ctx *gin.Context) {
+	var inp []*model.ChallengePrerequisite
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	challengeID := uuid.FromStringOrNil(ctx.Param("challengeID"))
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	if err := h.useCase.UpdateEventChallengePrerequisites(ctx, eventID, challengeID, inp); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Challenge prerequisites updated successfully")
+}
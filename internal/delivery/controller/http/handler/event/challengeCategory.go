@@ -16,6 +16,7 @@ type IChallengeCategoryUseCase interface {
 	DeleteEventCategory(ctx context.Context, eventID uuid.UUID, categoryID uuid.UUID) error
 
 	UpdateEventCategoriesOrder(ctx context.Context, eventID uuid.UUID, orders []model.Order) error
+	UpdateEventCategoryVisibility(ctx context.Context, eventID, categoryID uuid.UUID, visibility int32) error
 }
 
 func (h *Handler) initChallengeCategoryAPIHandler(router *gin.RouterGroup) {
@@ -25,6 +26,7 @@ func (h *Handler) initChallengeCategoryAPIHandler(router *gin.RouterGroup) {
 		categoryAPI.POST("", h.createCategory)
 		categoryAPI.PUT(":categoryID", h.updateCategory)
 		categoryAPI.DELETE(":categoryID", h.deleteCategory)
+		categoryAPI.PATCH(":categoryID/visibility", h.updateCategoryVisibility)
 
 		categoryAPI.PATCH("order", h.updateCategoriesOrder)
 	}
@@ -85,6 +87,23 @@ func (h *Handler) deleteCategory(ctx *gin.Context) {
 	response.AbortWithOK(ctx, "Category deleted successfully")
 }
 
+func (h *Handler) updateCategoryVisibility(ctx *gin.Context) {
+	var inp updateVisibilityInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	categoryID := uuid.FromStringOrNil(ctx.Param("categoryID"))
+
+	if err := h.useCase.UpdateEventCategoryVisibility(ctx, eventID, categoryID, inp.Visibility); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Category visibility updated successfully")
+}
+
 func (h *Handler) updateCategoriesOrder(ctx *gin.Context) {
 	var inp []model.Order
 	if err := ctx.BindJSON(&inp); err != nil {
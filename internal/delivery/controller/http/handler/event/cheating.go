@@ -0,0 +1,57 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/response"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+type ICheatingUseCase interface {
+	GetEventCheatingIncidents(ctx context.Context, eventID uuid.UUID) ([]*model.CheatingIncident, error)
+	UpdateTeamDisqualified(ctx context.Context, eventID, teamID uuid.UUID, disqualified bool) error
+}
+
+func (h *Handler) initCheatingAPIHandler(router *gin.RouterGroup) {
+	incidentAPI := router.Group("incidents", protection.RequireProtection)
+	{
+		incidentAPI.GET("", h.getCheatingIncidents) // get cheating incidents
+	}
+}
+
+func (h *Handler) getCheatingIncidents(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	incidents, err := h.useCase.GetEventCheatingIncidents(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithContent(ctx, incidents)
+}
+
+type updateTeamDisqualificationInput struct {
+	Disqualified bool
+}
+
+func (h *Handler) updateTeamDisqualification(ctx *gin.Context) {
+	var inp updateTeamDisqualificationInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	teamID := uuid.FromStringOrNil(ctx.Param("teamID"))
+
+	if err := h.useCase.UpdateTeamDisqualified(ctx, eventID, teamID, inp.Disqualified); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Team disqualification updated successfully")
+}
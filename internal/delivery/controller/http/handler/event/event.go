@@ -2,12 +2,17 @@ package event
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/cybericebox/daemon/internal/config"
 	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
 	"github.com/cybericebox/daemon/internal/delivery/controller/http/response"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"io"
+	"net/http"
 )
 
 type ISingleEventUseCase interface {
@@ -17,12 +22,13 @@ type ISingleEventUseCase interface {
 	DeleteEvent(ctx context.Context, eventID uuid.UUID) error
 
 	GetJoinEventStatus(ctx context.Context, eventID uuid.UUID) (int32, error)
-	JoinEvent(ctx context.Context, eventID uuid.UUID) error
+	JoinEvent(ctx context.Context, eventID uuid.UUID, invitationCode string) error
+	ProtectEvent(ctx context.Context, eventID uuid.UUID) (bool, error)
 }
 
 func (h *Handler) initSingleEventAPIHandler(router *gin.RouterGroup) {
 	router.GET("", protection.RequireProtection, h.getEvent) // get event
-	router.GET("info", h.getEventInfo)
+	router.GET("info", protection.DynamicallyRequireProtection(h.eventNeedProtection), h.getEventInfo)
 
 	router.PUT("", protection.RequireProtection, h.updateEvent)    // update event
 	router.DELETE("", protection.RequireProtection, h.deleteEvent) // delete event
@@ -31,12 +37,15 @@ func (h *Handler) initSingleEventAPIHandler(router *gin.RouterGroup) {
 	{
 		joinEventAPI.GET("", h.getJoinEventStatus)
 		joinEventAPI.POST("", h.joinEvent)
+		joinEventAPI.GET(":invitationCode", h.joinEventByInvitationLink) // invitation link
 	}
 
 	h.initChallengeAPIHandler(router)
 	h.initTeamAPIHandler(router)
 	h.initScoreAPIHandler(router)
 	h.initParticipantAPIHandler(router)
+	h.initInvitationAPIHandler(router)
+	h.initCheatingAPIHandler(router)
 }
 
 func (h *Handler) getEvent(ctx *gin.Context) {
@@ -102,12 +111,51 @@ func (h *Handler) getJoinEventStatus(ctx *gin.Context) {
 	response.AbortWithContent(ctx, gin.H{"Status": status})
 }
 
+type joinEventInput struct {
+	InvitationCode string
+}
+
 func (h *Handler) joinEvent(ctx *gin.Context) {
 	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
 
-	if err := h.useCase.JoinEvent(ctx, eventID); err != nil {
+	// invitation code is optional, so the body can be empty
+	var inp joinEventInput
+	if err := ctx.ShouldBindJSON(&inp); err != nil && !errors.Is(err, io.EOF) {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	if err := h.useCase.JoinEvent(ctx, eventID, inp.InvitationCode); err != nil {
 		response.AbortWithError(ctx, err)
 		return
 	}
 	response.AbortWithOK(ctx, "Event joined successfully")
 }
+
+func (h *Handler) joinEventByInvitationLink(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	if err := h.useCase.JoinEvent(ctx, eventID, ctx.Param("invitationCode")); err != nil && !errors.Is(err, model.ErrEventAlreadyJoined) {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	event, err := h.useCase.GetEventInfo(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	// redirect to the event frontend
+	response.Redirect(ctx, http.StatusSeeOther, fmt.Sprintf("%s://%s.%s", config.SchemeHTTPS, event.Tag, config.PlatformDomain))
+}
+
+func (h *Handler) eventNeedProtection(ctx *gin.Context) bool {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	needProtection, err := h.useCase.ProtectEvent(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return true
+	}
+	return needProtection
+}
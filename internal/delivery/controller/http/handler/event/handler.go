@@ -20,7 +20,11 @@ type (
 		IChallengeCategoryUseCase
 		ITeamUseCase
 		IParticipantUseCase
+		IInvitationUseCase
+		ICheatingUseCase
 		IScoreUseCase
+		IScoreAdjustmentUseCase
+		IScoreExportUseCase
 		ISingleEventUseCase
 
 		GetEvents(ctx context.Context) ([]*model.Event, error)
@@ -42,6 +46,8 @@ func (h *Handler) Init(router *gin.RouterGroup) {
 		eventAPI.GET("info", h.getEventsInfo)                          // get all events info only
 		eventAPI.POST("", protection.RequireProtection, h.createEvent) // create event
 
+		eventAPI.GET("invitations", protection.RequireProtection, h.getSelfInvitations) // get events the user is invited to
+
 		singleEventAPI := eventAPI.Group(":eventIDOrTag", h.setEventIDToContext)
 		h.initSingleEventAPIHandler(singleEventAPI)
 
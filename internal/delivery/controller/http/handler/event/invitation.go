@@ -0,0 +1,129 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/response"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type IInvitationUseCase interface {
+	GetEventInvitationCodes(ctx context.Context, eventID uuid.UUID) ([]*model.InvitationCode, error)
+	CreateEventInvitationCode(ctx context.Context, eventID uuid.UUID, maxUses int32, expiresAt *time.Time) (*model.InvitationCode, error)
+	DeleteEventInvitationCode(ctx context.Context, eventID, codeID uuid.UUID) error
+
+	GetEventInvitations(ctx context.Context, eventID uuid.UUID) ([]*model.Invitation, error)
+	InviteUsersToEvent(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error
+	DeleteEventInvitation(ctx context.Context, eventID, userID uuid.UUID) error
+
+	GetSelfInvitations(ctx context.Context) ([]*model.EventInfo, error)
+}
+
+func (h *Handler) initInvitationAPIHandler(router *gin.RouterGroup) {
+	invitationAPI := router.Group("invitations", protection.RequireProtection)
+	{
+		invitationAPI.GET("", h.getInvitations)             // get invited users
+		invitationAPI.POST("", h.inviteUsers)               // invite users
+		invitationAPI.DELETE(":userID", h.deleteInvitation) // delete user invitation
+
+		codeAPI := invitationAPI.Group("codes")
+		{
+			codeAPI.GET("", h.getInvitationCodes)             // get invitation codes
+			codeAPI.POST("", h.createInvitationCode)          // create invitation code
+			codeAPI.DELETE(":codeID", h.deleteInvitationCode) // delete invitation code
+		}
+	}
+}
+
+func (h *Handler) getInvitations(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	invitations, err := h.useCase.GetEventInvitations(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithContent(ctx, invitations)
+}
+
+type inviteUsersInput struct {
+	UserIDs []uuid.UUID
+}
+
+func (h *Handler) inviteUsers(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	var inp inviteUsersInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+	if err := h.useCase.InviteUsersToEvent(ctx, eventID, inp.UserIDs...); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Users invited successfully")
+}
+
+func (h *Handler) deleteInvitation(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	userID := uuid.FromStringOrNil(ctx.Param("userID"))
+
+	if err := h.useCase.DeleteEventInvitation(ctx, eventID, userID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Invitation deleted successfully")
+}
+
+func (h *Handler) getInvitationCodes(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	codes, err := h.useCase.GetEventInvitationCodes(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithContent(ctx, codes)
+}
+
+type createInvitationCodeInput struct {
+	MaxUses   int32
+	ExpiresAt *time.Time
+}
+
+func (h *Handler) createInvitationCode(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	var inp createInvitationCodeInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+	code, err := h.useCase.CreateEventInvitationCode(ctx, eventID, inp.MaxUses, inp.ExpiresAt)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithContent(ctx, code)
+}
+
+func (h *Handler) deleteInvitationCode(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	codeID := uuid.FromStringOrNil(ctx.Param("codeID"))
+
+	if err := h.useCase.DeleteEventInvitationCode(ctx, eventID, codeID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Invitation code deleted successfully")
+}
+
+func (h *Handler) getSelfInvitations(ctx *gin.Context) {
+	invitations, err := h.useCase.GetSelfInvitations(ctx)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithContent(ctx, invitations)
+}
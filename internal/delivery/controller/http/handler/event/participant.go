@@ -1,13 +1,120 @@
 package event
 
-import "github.com/gin-gonic/gin"
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/response"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
 
 type IParticipantUseCase interface {
+	GetEventParticipants(ctx context.Context, eventID uuid.UUID) ([]*model.Participant, error)
+	GetEventParticipantsInfo(ctx context.Context, eventID uuid.UUID) ([]*model.TeamParticipantsInfo, error)
+	ProtectParticipants(ctx context.Context, eventID uuid.UUID) (bool, error)
+	ApproveParticipants(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error
+	RejectParticipants(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error
 }
 
 func (h *Handler) initParticipantAPIHandler(router *gin.RouterGroup) {
 	participantsAPI := router.Group("participants")
 	{
-		participantsAPI.GET("")
+		participantsAPI.GET("", protection.RequireProtection, h.getParticipants)                                                  // get participants with their status and team
+		participantsAPI.GET("info", protection.DynamicallyRequireProtection(h.participantsNeedProtection), h.getParticipantsInfo) // get teams with their members
+
+		participantsAPI.POST("approve", protection.RequireProtection, h.approveParticipants) // approve participants in bulk
+		participantsAPI.POST("reject", protection.RequireProtection, h.rejectParticipants)   // reject participants in bulk
+
+		singleParticipantAPI := participantsAPI.Group(":userID", protection.RequireProtection)
+		{
+			singleParticipantAPI.POST("approve", h.approveParticipant) // approve participant
+			singleParticipantAPI.POST("reject", h.rejectParticipant)   // reject participant
+		}
+	}
+}
+
+func (h *Handler) getParticipants(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	participants, err := h.useCase.GetEventParticipants(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithContent(ctx, participants)
+}
+
+func (h *Handler) getParticipantsInfo(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	participants, err := h.useCase.GetEventParticipantsInfo(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithContent(ctx, participants)
+}
+
+func (h *Handler) participantsNeedProtection(ctx *gin.Context) bool {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	needProtection, err := h.useCase.ProtectParticipants(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return true
+	}
+	return needProtection
+}
+
+type participantsInput struct {
+	UserIDs []uuid.UUID
+}
+
+func (h *Handler) approveParticipants(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	var inp participantsInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+	if err := h.useCase.ApproveParticipants(ctx, eventID, inp.UserIDs...); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Participants approved successfully")
+}
+
+func (h *Handler) rejectParticipants(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	var inp participantsInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+	if err := h.useCase.RejectParticipants(ctx, eventID, inp.UserIDs...); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Participants rejected successfully")
+}
+
+func (h *Handler) approveParticipant(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	userID := uuid.FromStringOrNil(ctx.Param("userID"))
+
+	if err := h.useCase.ApproveParticipants(ctx, eventID, userID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Participant approved successfully")
+}
+
+func (h *Handler) rejectParticipant(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	userID := uuid.FromStringOrNil(ctx.Param("userID"))
+
+	if err := h.useCase.RejectParticipants(ctx, eventID, userID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
 	}
+	response.AbortWithOK(ctx, "Participant rejected successfully")
 }
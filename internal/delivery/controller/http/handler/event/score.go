@@ -2,29 +2,51 @@ package event
 
 import (
 	"context"
+	"github.com/cybericebox/daemon/internal/config"
 	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
 	"github.com/cybericebox/daemon/internal/delivery/controller/http/response"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"net/http"
+	"time"
 )
 
 type IScoreUseCase interface {
-	GetScore(ctx context.Context, eventID uuid.UUID) (*model.EventScore, error)
+	GetScore(ctx context.Context, eventID uuid.UUID, at *time.Time) (*model.EventScore, error)
+	GetScoreFeed(ctx context.Context, eventID uuid.UUID) (<-chan *model.ScoreFeedEvent, error)
+	RevealScoreboard(ctx context.Context, eventID uuid.UUID, revealed bool) error
 	ProtectScore(ctx context.Context, eventID uuid.UUID) (bool, error)
 }
 
 func (h *Handler) initScoreAPIHandler(router *gin.RouterGroup) {
 	scoreAPI := router.Group("score", protection.DynamicallyRequireProtection(h.scoreNeedProtection))
 	{
-		scoreAPI.GET("", h.getScore)
+		scoreAPI.GET("", h.getScore)                                          // get score, the at query parameter replays the score at the given time
+		scoreAPI.GET("stream", h.streamScore)                                 // stream score updates, solves and first bloods
+		scoreAPI.PATCH("reveal", protection.RequireProtection, h.revealScore) // reveal or hide the frozen standings
+
+		h.initScoreAdjustmentAPIHandler(scoreAPI)
 	}
+
+	h.initScoreExportAPIHandler(router)
 }
 
 func (h *Handler) getScore(ctx *gin.Context) {
 	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
-	score, err := h.useCase.GetScore(ctx, eventID)
+
+	var at *time.Time
+	if atQuery := ctx.Query("at"); atQuery != "" {
+		parsed, err := time.Parse(time.RFC3339, atQuery)
+		if err != nil {
+			response.AbortWithBadRequest(ctx, err)
+			return
+		}
+		at = &parsed
+	}
+
+	score, err := h.useCase.GetScore(ctx, eventID, at)
 	if err != nil {
 		response.AbortWithError(ctx, err)
 		return
@@ -32,6 +54,82 @@ func (h *Handler) getScore(ctx *gin.Context) {
 	response.AbortWithContent(ctx, score)
 }
 
+func (h *Handler) revealScore(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	var inp struct {
+		Revealed bool
+	}
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	if err := h.useCase.RevealScoreboard(ctx, eventID, inp.Revealed); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Scoreboard reveal updated successfully")
+}
+
+var scoreFeedEventNames = map[int32]string{
+	model.ScoreUpdatedFeedEventType: "score",
+	model.SolveFeedEventType:        "solve",
+	model.FirstBloodFeedEventType:   "firstBlood",
+}
+
+func (h *Handler) streamScore(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	// the gin context is not cancelled by the client disconnect, so the feed is stopped when the stream ends,
+	// the feed outlives the handler and the gin context is reused by the next request, so its copy is used
+	feedCtx, cancel := context.WithCancel(ctx.Copy())
+	defer cancel()
+
+	feed, err := h.useCase.GetScoreFeed(feedCtx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	controller := http.NewResponseController(ctx.Writer)
+	heartbeat := time.NewTicker(config.ScoreFeedHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		// the stream lasts longer than the server write timeout, so the deadline is moved before each write
+		if err = controller.SetWriteDeadline(time.Now().Add(2 * config.ScoreFeedHeartbeatInterval)); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case event, ok := <-feed:
+			if !ok {
+				return
+			}
+
+			if event.Type == model.ScoreUpdatedFeedEventType {
+				ctx.SSEvent(scoreFeedEventNames[event.Type], event.Score)
+			} else {
+				ctx.SSEvent(scoreFeedEventNames[event.Type], event.Solve)
+			}
+		case <-heartbeat.C:
+			if _, err = ctx.Writer.WriteString(": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+
+		ctx.Writer.Flush()
+	}
+}
+
 func (h *Handler) scoreNeedProtection(ctx *gin.Context) bool {
 	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
 	needProtection, err := h.useCase.ProtectScore(ctx, eventID)
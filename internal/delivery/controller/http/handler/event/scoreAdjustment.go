@@ -0,0 +1,99 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/response"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+type IScoreAdjustmentUseCase interface {
+	GetEventScoreAdjustments(ctx context.Context, eventID uuid.UUID) ([]*model.ScoreAdjustment, error)
+	CreateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) (*model.ScoreAdjustment, error)
+	UpdateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) error
+	DeleteEventScoreAdjustment(ctx context.Context, eventID, adjustmentID uuid.UUID) error
+}
+
+func (h *Handler) initScoreAdjustmentAPIHandler(router *gin.RouterGroup) {
+	adjustmentAPI := router.Group("adjustments", protection.RequireProtection)
+	{
+		adjustmentAPI.GET("", h.getScoreAdjustments)                   // get score adjustments
+		adjustmentAPI.POST("", h.createScoreAdjustment)                // award or penalize the team
+		adjustmentAPI.PUT(":adjustmentID", h.updateScoreAdjustment)    // update score adjustment
+		adjustmentAPI.DELETE(":adjustmentID", h.deleteScoreAdjustment) // delete score adjustment
+	}
+}
+
+func (h *Handler) getScoreAdjustments(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	adjustments, err := h.useCase.GetEventScoreAdjustments(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithContent(ctx, adjustments)
+}
+
+type scoreAdjustmentInput struct {
+	TeamID uuid.UUID
+	Points int32
+	Reason string
+}
+
+func (h *Handler) createScoreAdjustment(ctx *gin.Context) {
+	var inp scoreAdjustmentInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	adjustment, err := h.useCase.CreateEventScoreAdjustment(ctx, &model.ScoreAdjustment{
+		EventID: uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey)),
+		TeamID:  inp.TeamID,
+		Points:  inp.Points,
+		Reason:  inp.Reason,
+	})
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithContent(ctx, adjustment)
+}
+
+func (h *Handler) updateScoreAdjustment(ctx *gin.Context) {
+	var inp scoreAdjustmentInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+
+	if err := h.useCase.UpdateEventScoreAdjustment(ctx, &model.ScoreAdjustment{
+		ID:      uuid.FromStringOrNil(ctx.Param("adjustmentID")),
+		EventID: uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey)),
+		Points:  inp.Points,
+		Reason:  inp.Reason,
+	}); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Score adjustment updated successfully")
+}
+
+func (h *Handler) deleteScoreAdjustment(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	adjustmentID := uuid.FromStringOrNil(ctx.Param("adjustmentID"))
+
+	if err := h.useCase.DeleteEventScoreAdjustment(ctx, eventID, adjustmentID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	response.AbortWithOK(ctx, "Score adjustment deleted successfully")
+}
@@ -0,0 +1,189 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/protection"
+	"github.com/cybericebox/daemon/internal/delivery/controller/http/response"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type (
+	IScoreExportUseCase interface {
+		ExportScore(ctx context.Context, eventID uuid.UUID) (*model.EventScore, error)
+		ProtectScoreExport(ctx context.Context, eventID uuid.UUID) (bool, error)
+	}
+
+	// ctftimeScoreboard is the scoreboard feed format accepted by CTFtime
+	ctftimeScoreboard struct {
+		Tasks     []string          `json:"tasks"`
+		Standings []ctftimeStanding `json:"standings"`
+	}
+
+	ctftimeStanding struct {
+		Pos        int                        `json:"pos"`
+		Team       string                     `json:"team"`
+		Score      int                        `json:"score"`
+		TaskStats  map[string]ctftimeTaskStat `json:"taskStats,omitempty"`
+		LastAccept int64                      `json:"lastAccept,omitempty"`
+	}
+
+	ctftimeTaskStat struct {
+		Points int   `json:"points"`
+		Time   int64 `json:"time"`
+	}
+)
+
+const (
+	ctftimeScoreExportFormat = "ctftime"
+	csvScoreExportFormat     = "csv"
+)
+
+func (h *Handler) initScoreExportAPIHandler(router *gin.RouterGroup) {
+	// the export has its own protection, so it is not registered in the score group
+	router.GET("score/export", protection.DynamicallyRequireProtection(h.scoreExportNeedProtection), h.exportScore) // export score in CTFtime or CSV format
+}
+
+func (h *Handler) exportScore(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	format := ctx.DefaultQuery("format", ctftimeScoreExportFormat)
+	if format != ctftimeScoreExportFormat && format != csvScoreExportFormat {
+		response.AbortWithError(ctx, model.ErrScoreExportFormatInvalid)
+		return
+	}
+
+	score, err := h.useCase.ExportScore(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+
+	if format == csvScoreExportFormat {
+		data, err := scoreToCSV(score)
+		if err != nil {
+			response.AbortWithError(ctx, err)
+			return
+		}
+		ctx.Header("Content-Disposition", `attachment; filename="scoreboard.csv"`)
+		ctx.Data(http.StatusOK, "text/csv; charset=utf-8", data)
+		ctx.Abort()
+		return
+	}
+
+	data, err := json.Marshal(scoreToCTFtime(score))
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	ctx.Header("Content-Disposition", `attachment; filename="scoreboard.json"`)
+	ctx.Data(http.StatusOK, "application/json", data)
+	ctx.Abort()
+}
+
+func (h *Handler) scoreExportNeedProtection(ctx *gin.Context) bool {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	needProtection, err := h.useCase.ProtectScoreExport(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return true
+	}
+	return needProtection
+}
+
+func scoreToCTFtime(score *model.EventScore) ctftimeScoreboard {
+	challengesByID := make(map[uuid.UUID]model.ChallengeInfo, len(score.ChallengeList))
+	board := ctftimeScoreboard{
+		Tasks:     make([]string, 0, len(score.ChallengeList)),
+		Standings: make([]ctftimeStanding, 0, len(score.TeamsScores)),
+	}
+
+	for _, challenge := range score.ChallengeList {
+		challengesByID[challenge.ID] = challenge
+		board.Tasks = append(board.Tasks, challenge.Name)
+	}
+
+	for _, team := range score.TeamsScores {
+		standing := ctftimeStanding{
+			Pos:       team.Rank,
+			Team:      team.TeamName,
+			Score:     team.Score,
+			TaskStats: make(map[string]ctftimeTaskStat, len(team.TeamSolutions)),
+		}
+
+		// the latest solution time of the team without solutions is not the accepted flag time
+		if len(team.TeamSolutions) > 0 && !team.LatestSolution.IsZero() {
+			standing.LastAccept = team.LatestSolution.Unix()
+		}
+
+		for challengeID, solution := range team.TeamSolutions {
+			challenge, ok := challengesByID[challengeID]
+			if !ok {
+				continue
+			}
+
+			standing.TaskStats[challenge.Name] = ctftimeTaskStat{
+				Points: int(challenge.Points) + solution.Bonus,
+				Time:   solution.SolvedAt.Unix(),
+			}
+		}
+
+		board.Standings = append(board.Standings, standing)
+	}
+
+	return board
+}
+
+// scoreToCSV renders the standings with a column per challenge, the solved challenges contain the gained points
+func scoreToCSV(score *model.EventScore) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	header := []string{"Rank", "Team", "Score"}
+	for _, challenge := range score.ChallengeList {
+		header = append(header, csvSafeCell(challenge.Name))
+	}
+
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, team := range score.TeamsScores {
+		record := []string{strconv.Itoa(team.Rank), csvSafeCell(team.TeamName), strconv.Itoa(team.Score)}
+		for _, challenge := range score.ChallengeList {
+			solution, ok := team.TeamSolutions[challenge.ID]
+			if !ok {
+				record = append(record, "")
+				continue
+			}
+			record = append(record, strconv.Itoa(int(challenge.Points)+solution.Bonus))
+		}
+
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// csvSafeCell prefixes the names set by the users, which the spreadsheet applications would evaluate as formulas
+func csvSafeCell(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+	return value
+}
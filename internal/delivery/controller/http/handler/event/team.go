@@ -17,6 +17,13 @@ type ITeamUseCase interface {
 	JoinTeam(ctx context.Context, eventID uuid.UUID, name, joinCode string) error
 	GetVPNConfig(ctx context.Context, eventID uuid.UUID) (string, error)
 	GetSelfTeam(ctx context.Context, eventID uuid.UUID) (*model.Team, error)
+	ReconcileEventTeamChallenges(ctx context.Context, eventID, teamID uuid.UUID) error
+
+	RenameTeam(ctx context.Context, eventID uuid.UUID, name string) error
+	RegenerateTeamJoinCode(ctx context.Context, eventID uuid.UUID) (string, error)
+	TransferTeamCaptaincy(ctx context.Context, eventID, userID uuid.UUID) error
+	KickTeamMember(ctx context.Context, eventID, userID uuid.UUID) error
+	LeaveTeam(ctx context.Context, eventID uuid.UUID) error
 }
 
 func (h *Handler) initTeamAPIHandler(router *gin.RouterGroup) {
@@ -28,11 +35,20 @@ func (h *Handler) initTeamAPIHandler(router *gin.RouterGroup) {
 		teamAPI.POST("", protection.RequireProtection, h.createTeam)   // create team
 		teamAPI.POST("join", protection.RequireProtection, h.joinTeam) // join team
 
+		teamAPI.POST(":teamID/reconcile", protection.RequireProtection, h.reconcileTeamChallenges)            // reconcile team challenges
+		teamAPI.PATCH(":teamID/disqualification", protection.RequireProtection, h.updateTeamDisqualification) // disqualify or restore team
+
 		// self team
 		selfTeamAPI := teamAPI.Group("self", protection.RequireProtection)
 		{
 			selfTeamAPI.GET("", h.getSelfTeam)            // get team
 			selfTeamAPI.GET("vpn-config", h.getVPNConfig) // get vpn config
+
+			selfTeamAPI.PATCH("", h.renameTeam)                     // rename team, captain only
+			selfTeamAPI.POST("join-code", h.regenerateTeamJoinCode) // rotate join code, captain only
+			selfTeamAPI.POST("captain", h.transferTeamCaptaincy)    // transfer captaincy, captain only
+			selfTeamAPI.DELETE("members/:userID", h.kickTeamMember) // kick member, captain only
+			selfTeamAPI.POST("leave", h.leaveTeam)                  // leave team
 		}
 
 	}
@@ -95,6 +111,17 @@ func (h *Handler) joinTeam(ctx *gin.Context) {
 	response.AbortWithOK(ctx, "Team joined successfully")
 }
 
+func (h *Handler) reconcileTeamChallenges(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	teamID := uuid.FromStringOrNil(ctx.Param("teamID"))
+
+	if err := h.useCase.ReconcileEventTeamChallenges(ctx, eventID, teamID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Team challenges reconciled successfully")
+}
+
 func (h *Handler) getSelfTeam(ctx *gin.Context) {
 	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
 	team, err := h.useCase.GetSelfTeam(ctx, eventID)
@@ -114,3 +141,66 @@ func (h *Handler) getVPNConfig(ctx *gin.Context) {
 	}
 	response.AbortWithContent(ctx, cfg)
 }
+
+func (h *Handler) renameTeam(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	var inp createTeamInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+	if err := h.useCase.RenameTeam(ctx, eventID, inp.Name); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Team renamed successfully")
+}
+
+func (h *Handler) regenerateTeamJoinCode(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	joinCode, err := h.useCase.RegenerateTeamJoinCode(ctx, eventID)
+	if err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithContent(ctx, gin.H{"JoinCode": joinCode})
+}
+
+type transferTeamCaptaincyInput struct {
+	UserID uuid.UUID
+}
+
+func (h *Handler) transferTeamCaptaincy(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	var inp transferTeamCaptaincyInput
+	if err := ctx.BindJSON(&inp); err != nil {
+		response.AbortWithBadRequest(ctx, err)
+		return
+	}
+	if err := h.useCase.TransferTeamCaptaincy(ctx, eventID, inp.UserID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Team captaincy transferred successfully")
+}
+
+func (h *Handler) kickTeamMember(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+	userID := uuid.FromStringOrNil(ctx.Param("userID"))
+
+	if err := h.useCase.KickTeamMember(ctx, eventID, userID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Team member kicked successfully")
+}
+
+func (h *Handler) leaveTeam(ctx *gin.Context) {
+	eventID := uuid.FromStringOrNil(ctx.GetString(tools.EventIDCtxKey))
+
+	if err := h.useCase.LeaveTeam(ctx, eventID); err != nil {
+		response.AbortWithError(ctx, err)
+		return
+	}
+	response.AbortWithOK(ctx, "Team left successfully")
+}
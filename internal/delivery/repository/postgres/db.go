@@ -27,6 +27,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countChallengesInEventsStmt, err = db.PrepareContext(ctx, countChallengesInEvents); err != nil {
 		return nil, fmt.Errorf("error preparing query CountChallengesInEvents: %w", err)
 	}
+	if q.countEventActiveParticipantsStmt, err = db.PrepareContext(ctx, countEventActiveParticipants); err != nil {
+		return nil, fmt.Errorf("error preparing query CountEventActiveParticipants: %w", err)
+	}
+	if q.countEventTeamMembersStmt, err = db.PrepareContext(ctx, countEventTeamMembers); err != nil {
+		return nil, fmt.Errorf("error preparing query CountEventTeamMembers: %w", err)
+	}
+	if q.countEventTeamsStmt, err = db.PrepareContext(ctx, countEventTeams); err != nil {
+		return nil, fmt.Errorf("error preparing query CountEventTeams: %w", err)
+	}
 	if q.countTeamsInEventsStmt, err = db.PrepareContext(ctx, countTeamsInEvents); err != nil {
 		return nil, fmt.Errorf("error preparing query CountTeamsInEvents: %w", err)
 	}
@@ -39,15 +48,42 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createEventChallengeCategoryStmt, err = db.PrepareContext(ctx, createEventChallengeCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateEventChallengeCategory: %w", err)
 	}
+	if q.createEventChallengeHintStmt, err = db.PrepareContext(ctx, createEventChallengeHint); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventChallengeHint: %w", err)
+	}
+	if q.createEventChallengePrerequisiteStmt, err = db.PrepareContext(ctx, createEventChallengePrerequisite); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventChallengePrerequisite: %w", err)
+	}
+	if q.createEventChallengePrerequisiteChallengeStmt, err = db.PrepareContext(ctx, createEventChallengePrerequisiteChallenge); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventChallengePrerequisiteChallenge: %w", err)
+	}
 	if q.createEventChallengeSolutionAttemptStmt, err = db.PrepareContext(ctx, createEventChallengeSolutionAttempt); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateEventChallengeSolutionAttempt: %w", err)
 	}
+	if q.createEventCheatingIncidentStmt, err = db.PrepareContext(ctx, createEventCheatingIncident); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventCheatingIncident: %w", err)
+	}
+	if q.createEventInvitationStmt, err = db.PrepareContext(ctx, createEventInvitation); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventInvitation: %w", err)
+	}
+	if q.createEventInvitationCodeStmt, err = db.PrepareContext(ctx, createEventInvitationCode); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventInvitationCode: %w", err)
+	}
 	if q.createEventParticipantStmt, err = db.PrepareContext(ctx, createEventParticipant); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateEventParticipant: %w", err)
 	}
+	if q.createEventScoreAdjustmentStmt, err = db.PrepareContext(ctx, createEventScoreAdjustment); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventScoreAdjustment: %w", err)
+	}
 	if q.createEventTeamChallengeStmt, err = db.PrepareContext(ctx, createEventTeamChallenge); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateEventTeamChallenge: %w", err)
 	}
+	if q.createEventTeamHintUnlockStmt, err = db.PrepareContext(ctx, createEventTeamHintUnlock); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventTeamHintUnlock: %w", err)
+	}
+	if q.createEventTeamLabChallengeStmt, err = db.PrepareContext(ctx, createEventTeamLabChallenge); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEventTeamLabChallenge: %w", err)
+	}
 	if q.createExerciseStmt, err = db.PrepareContext(ctx, createExercise); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateExercise: %w", err)
 	}
@@ -57,6 +93,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createFileStmt, err = db.PrepareContext(ctx, createFile); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateFile: %w", err)
 	}
+	if q.createJobStmt, err = db.PrepareContext(ctx, createJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateJob: %w", err)
+	}
 	if q.createTeamInEventStmt, err = db.PrepareContext(ctx, createTeamInEvent); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTeamInEvent: %w", err)
 	}
@@ -72,9 +111,45 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteEventChallengeCategoryStmt, err = db.PrepareContext(ctx, deleteEventChallengeCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteEventChallengeCategory: %w", err)
 	}
+	if q.deleteEventChallengePrerequisitesStmt, err = db.PrepareContext(ctx, deleteEventChallengePrerequisites); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventChallengePrerequisites: %w", err)
+	}
 	if q.deleteEventChallengesStmt, err = db.PrepareContext(ctx, deleteEventChallenges); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteEventChallenges: %w", err)
 	}
+	if q.deleteEventInvitationStmt, err = db.PrepareContext(ctx, deleteEventInvitation); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventInvitation: %w", err)
+	}
+	if q.deleteEventInvitationCodeStmt, err = db.PrepareContext(ctx, deleteEventInvitationCode); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventInvitationCode: %w", err)
+	}
+	if q.deleteEventLabChallengesStmt, err = db.PrepareContext(ctx, deleteEventLabChallenges); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventLabChallenges: %w", err)
+	}
+	if q.deleteEventParticipantStmt, err = db.PrepareContext(ctx, deleteEventParticipant); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventParticipant: %w", err)
+	}
+	if q.deleteEventScoreAdjustmentStmt, err = db.PrepareContext(ctx, deleteEventScoreAdjustment); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventScoreAdjustment: %w", err)
+	}
+	if q.deleteEventTeamStmt, err = db.PrepareContext(ctx, deleteEventTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventTeam: %w", err)
+	}
+	if q.deleteEventTeamChallengeStmt, err = db.PrepareContext(ctx, deleteEventTeamChallenge); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventTeamChallenge: %w", err)
+	}
+	if q.deleteEventTeamLabChallengeStmt, err = db.PrepareContext(ctx, deleteEventTeamLabChallenge); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventTeamLabChallenge: %w", err)
+	}
+	if q.deleteEventTeamLabChallengesStmt, err = db.PrepareContext(ctx, deleteEventTeamLabChallenges); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventTeamLabChallenges: %w", err)
+	}
+	if q.deleteEventTeamsLabChallengesStmt, err = db.PrepareContext(ctx, deleteEventTeamsLabChallenges); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventTeamsLabChallenges: %w", err)
+	}
+	if q.deleteEventTeamsLaboratoriesStmt, err = db.PrepareContext(ctx, deleteEventTeamsLaboratories); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventTeamsLaboratories: %w", err)
+	}
 	if q.deleteExerciseStmt, err = db.PrepareContext(ctx, deleteExercise); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteExercise: %w", err)
 	}
@@ -84,6 +159,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteFileStmt, err = db.PrepareContext(ctx, deleteFile); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteFile: %w", err)
 	}
+	if q.deleteJobStmt, err = db.PrepareContext(ctx, deleteJob); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteJob: %w", err)
+	}
+	if q.deleteJobByKeyStmt, err = db.PrepareContext(ctx, deleteJobByKey); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteJobByKey: %w", err)
+	}
 	if q.deleteTemporalCodeStmt, err = db.PrepareContext(ctx, deleteTemporalCode); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteTemporalCode: %w", err)
 	}
@@ -93,6 +174,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.doesUserExistByIDStmt, err = db.PrepareContext(ctx, doesUserExistByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DoesUserExistByID: %w", err)
 	}
+	if q.eventInvitationCodeValidStmt, err = db.PrepareContext(ctx, eventInvitationCodeValid); err != nil {
+		return nil, fmt.Errorf("error preparing query EventInvitationCodeValid: %w", err)
+	}
+	if q.eventInvitationExistsStmt, err = db.PrepareContext(ctx, eventInvitationExists); err != nil {
+		return nil, fmt.Errorf("error preparing query EventInvitationExists: %w", err)
+	}
+	if q.eventTeamActivityExistsStmt, err = db.PrepareContext(ctx, eventTeamActivityExists); err != nil {
+		return nil, fmt.Errorf("error preparing query EventTeamActivityExists: %w", err)
+	}
+	if q.eventTeamCheatingIncidentExistsStmt, err = db.PrepareContext(ctx, eventTeamCheatingIncidentExists); err != nil {
+		return nil, fmt.Errorf("error preparing query EventTeamCheatingIncidentExists: %w", err)
+	}
 	if q.getAllChallengesSolutionsInEventStmt, err = db.PrepareContext(ctx, getAllChallengesSolutionsInEvent); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllChallengesSolutionsInEvent: %w", err)
 	}
@@ -105,6 +198,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getChallengeFlagStmt, err = db.PrepareContext(ctx, getChallengeFlag); err != nil {
 		return nil, fmt.Errorf("error preparing query GetChallengeFlag: %w", err)
 	}
+	if q.getChallengeFlagOwnerTeamIDStmt, err = db.PrepareContext(ctx, getChallengeFlagOwnerTeamID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetChallengeFlagOwnerTeamID: %w", err)
+	}
 	if q.getEmailTemplateBodyStmt, err = db.PrepareContext(ctx, getEmailTemplateBody); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEmailTemplateBody: %w", err)
 	}
@@ -126,12 +222,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getEventChallengesStmt, err = db.PrepareContext(ctx, getEventChallenges); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEventChallenges: %w", err)
 	}
+	if q.getEventChallengesHintsStmt, err = db.PrepareContext(ctx, getEventChallengesHints); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventChallengesHints: %w", err)
+	}
+	if q.getEventChallengesPrerequisiteChallengesStmt, err = db.PrepareContext(ctx, getEventChallengesPrerequisiteChallenges); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventChallengesPrerequisiteChallenges: %w", err)
+	}
+	if q.getEventChallengesPrerequisitesStmt, err = db.PrepareContext(ctx, getEventChallengesPrerequisites); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventChallengesPrerequisites: %w", err)
+	}
+	if q.getEventCheatingIncidentsStmt, err = db.PrepareContext(ctx, getEventCheatingIncidents); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventCheatingIncidents: %w", err)
+	}
+	if q.getEventHintUnlocksStmt, err = db.PrepareContext(ctx, getEventHintUnlocks); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventHintUnlocks: %w", err)
+	}
 	if q.getEventIDIfNotWithdrawnStmt, err = db.PrepareContext(ctx, getEventIDIfNotWithdrawn); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEventIDIfNotWithdrawn: %w", err)
 	}
 	if q.getEventIDIfRunningStmt, err = db.PrepareContext(ctx, getEventIDIfRunning); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEventIDIfRunning: %w", err)
 	}
+	if q.getEventInvitationCodesStmt, err = db.PrepareContext(ctx, getEventInvitationCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventInvitationCodes: %w", err)
+	}
+	if q.getEventInvitationsStmt, err = db.PrepareContext(ctx, getEventInvitations); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventInvitations: %w", err)
+	}
 	if q.getEventJoinStatusStmt, err = db.PrepareContext(ctx, getEventJoinStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEventJoinStatus: %w", err)
 	}
@@ -141,12 +258,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getEventParticipantTeamIDStmt, err = db.PrepareContext(ctx, getEventParticipantTeamID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEventParticipantTeamID: %w", err)
 	}
+	if q.getEventParticipantsStmt, err = db.PrepareContext(ctx, getEventParticipants); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventParticipants: %w", err)
+	}
+	if q.getEventParticipantsUserIDsStmt, err = db.PrepareContext(ctx, getEventParticipantsUserIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventParticipantsUserIDs: %w", err)
+	}
+	if q.getEventScoreAdjustmentsStmt, err = db.PrepareContext(ctx, getEventScoreAdjustments); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventScoreAdjustments: %w", err)
+	}
+	if q.getEventTeamByIDStmt, err = db.PrepareContext(ctx, getEventTeamByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventTeamByID: %w", err)
+	}
 	if q.getEventTeamByNameStmt, err = db.PrepareContext(ctx, getEventTeamByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEventTeamByName: %w", err)
 	}
+	if q.getEventTeamChallengesStmt, err = db.PrepareContext(ctx, getEventTeamChallenges); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventTeamChallenges: %w", err)
+	}
+	if q.getEventTeamLabChallengesStmt, err = db.PrepareContext(ctx, getEventTeamLabChallenges); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventTeamLabChallenges: %w", err)
+	}
+	if q.getEventTeamMembersStmt, err = db.PrepareContext(ctx, getEventTeamMembers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventTeamMembers: %w", err)
+	}
+	if q.getEventTeamUnlockedHintIDsStmt, err = db.PrepareContext(ctx, getEventTeamUnlockedHintIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventTeamUnlockedHintIDs: %w", err)
+	}
 	if q.getEventTeamsStmt, err = db.PrepareContext(ctx, getEventTeams); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEventTeams: %w", err)
 	}
+	if q.getEventWaitlistedParticipantsStmt, err = db.PrepareContext(ctx, getEventWaitlistedParticipants); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEventWaitlistedParticipants: %w", err)
+	}
 	if q.getExerciseByIDStmt, err = db.PrepareContext(ctx, getExerciseByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetExerciseByID: %w", err)
 	}
@@ -162,6 +306,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getFileByIDStmt, err = db.PrepareContext(ctx, getFileByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetFileByID: %w", err)
 	}
+	if q.getJobByKeyStmt, err = db.PrepareContext(ctx, getJobByKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetJobByKey: %w", err)
+	}
+	if q.getJobsByStatusStmt, err = db.PrepareContext(ctx, getJobsByStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query GetJobsByStatus: %w", err)
+	}
+	if q.getTeamChallengeSolutionAttemptTimesStmt, err = db.PrepareContext(ctx, getTeamChallengeSolutionAttemptTimes); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTeamChallengeSolutionAttemptTimes: %w", err)
+	}
+	if q.getTeamSolvedChallengeIDsInEventStmt, err = db.PrepareContext(ctx, getTeamSolvedChallengeIDsInEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTeamSolvedChallengeIDsInEvent: %w", err)
+	}
 	if q.getTeamsSolvedChallengeInEventStmt, err = db.PrepareContext(ctx, getTeamsSolvedChallengeInEvent); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTeamsSolvedChallengeInEvent: %w", err)
 	}
@@ -174,9 +330,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
 	}
+	if q.getUserInvitedEventIDsStmt, err = db.PrepareContext(ctx, getUserInvitedEventIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserInvitedEventIDs: %w", err)
+	}
 	if q.getUsersWithSimilarStmt, err = db.PrepareContext(ctx, getUsersWithSimilar); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUsersWithSimilar: %w", err)
 	}
+	if q.releaseEventInvitationCodeUseStmt, err = db.PrepareContext(ctx, releaseEventInvitationCodeUse); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseEventInvitationCodeUse: %w", err)
+	}
 	if q.setLastSeenStmt, err = db.PrepareContext(ctx, setLastSeen); err != nil {
 		return nil, fmt.Errorf("error preparing query SetLastSeen: %w", err)
 	}
@@ -192,21 +354,57 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateEventChallengeCategoryOrderStmt, err = db.PrepareContext(ctx, updateEventChallengeCategoryOrder); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateEventChallengeCategoryOrder: %w", err)
 	}
+	if q.updateEventChallengeCategoryVisibilityStmt, err = db.PrepareContext(ctx, updateEventChallengeCategoryVisibility); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventChallengeCategoryVisibility: %w", err)
+	}
 	if q.updateEventChallengeOrderStmt, err = db.PrepareContext(ctx, updateEventChallengeOrder); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateEventChallengeOrder: %w", err)
 	}
+	if q.updateEventChallengeReleaseTimeStmt, err = db.PrepareContext(ctx, updateEventChallengeReleaseTime); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventChallengeReleaseTime: %w", err)
+	}
+	if q.updateEventChallengeScoringStmt, err = db.PrepareContext(ctx, updateEventChallengeScoring); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventChallengeScoring: %w", err)
+	}
+	if q.updateEventChallengeVisibilityStmt, err = db.PrepareContext(ctx, updateEventChallengeVisibility); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventChallengeVisibility: %w", err)
+	}
 	if q.updateEventParticipantStatusStmt, err = db.PrepareContext(ctx, updateEventParticipantStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateEventParticipantStatus: %w", err)
 	}
 	if q.updateEventParticipantTeamStmt, err = db.PrepareContext(ctx, updateEventParticipantTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateEventParticipantTeam: %w", err)
 	}
+	if q.updateEventScoreAdjustmentStmt, err = db.PrepareContext(ctx, updateEventScoreAdjustment); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventScoreAdjustment: %w", err)
+	}
+	if q.updateEventScoreboardRevealedStmt, err = db.PrepareContext(ctx, updateEventScoreboardRevealed); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventScoreboardRevealed: %w", err)
+	}
+	if q.updateEventTeamCaptainStmt, err = db.PrepareContext(ctx, updateEventTeamCaptain); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventTeamCaptain: %w", err)
+	}
+	if q.updateEventTeamDisqualifiedStmt, err = db.PrepareContext(ctx, updateEventTeamDisqualified); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventTeamDisqualified: %w", err)
+	}
+	if q.updateEventTeamJoinCodeStmt, err = db.PrepareContext(ctx, updateEventTeamJoinCode); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventTeamJoinCode: %w", err)
+	}
+	if q.updateEventTeamLaboratoryStmt, err = db.PrepareContext(ctx, updateEventTeamLaboratory); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventTeamLaboratory: %w", err)
+	}
+	if q.updateEventTeamNameStmt, err = db.PrepareContext(ctx, updateEventTeamName); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEventTeamName: %w", err)
+	}
 	if q.updateExerciseStmt, err = db.PrepareContext(ctx, updateExercise); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateExercise: %w", err)
 	}
 	if q.updateExerciseCategoryStmt, err = db.PrepareContext(ctx, updateExerciseCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateExerciseCategory: %w", err)
 	}
+	if q.updateJobAttemptStmt, err = db.PrepareContext(ctx, updateJobAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateJobAttempt: %w", err)
+	}
 	if q.updateUserEmailStmt, err = db.PrepareContext(ctx, updateUserEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserEmail: %w", err)
 	}
@@ -225,6 +423,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateUserRoleStmt, err = db.PrepareContext(ctx, updateUserRole); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserRole: %w", err)
 	}
+	if q.useEventInvitationCodeStmt, err = db.PrepareContext(ctx, useEventInvitationCode); err != nil {
+		return nil, fmt.Errorf("error preparing query UseEventInvitationCode: %w", err)
+	}
 	return &q, nil
 }
 
@@ -235,6 +436,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countChallengesInEventsStmt: %w", cerr)
 		}
 	}
+	if q.countEventActiveParticipantsStmt != nil {
+		if cerr := q.countEventActiveParticipantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countEventActiveParticipantsStmt: %w", cerr)
+		}
+	}
+	if q.countEventTeamMembersStmt != nil {
+		if cerr := q.countEventTeamMembersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countEventTeamMembersStmt: %w", cerr)
+		}
+	}
+	if q.countEventTeamsStmt != nil {
+		if cerr := q.countEventTeamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countEventTeamsStmt: %w", cerr)
+		}
+	}
 	if q.countTeamsInEventsStmt != nil {
 		if cerr := q.countTeamsInEventsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countTeamsInEventsStmt: %w", cerr)
@@ -255,21 +471,66 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createEventChallengeCategoryStmt: %w", cerr)
 		}
 	}
+	if q.createEventChallengeHintStmt != nil {
+		if cerr := q.createEventChallengeHintStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventChallengeHintStmt: %w", cerr)
+		}
+	}
+	if q.createEventChallengePrerequisiteStmt != nil {
+		if cerr := q.createEventChallengePrerequisiteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventChallengePrerequisiteStmt: %w", cerr)
+		}
+	}
+	if q.createEventChallengePrerequisiteChallengeStmt != nil {
+		if cerr := q.createEventChallengePrerequisiteChallengeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventChallengePrerequisiteChallengeStmt: %w", cerr)
+		}
+	}
 	if q.createEventChallengeSolutionAttemptStmt != nil {
 		if cerr := q.createEventChallengeSolutionAttemptStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createEventChallengeSolutionAttemptStmt: %w", cerr)
 		}
 	}
+	if q.createEventCheatingIncidentStmt != nil {
+		if cerr := q.createEventCheatingIncidentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventCheatingIncidentStmt: %w", cerr)
+		}
+	}
+	if q.createEventInvitationStmt != nil {
+		if cerr := q.createEventInvitationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventInvitationStmt: %w", cerr)
+		}
+	}
+	if q.createEventInvitationCodeStmt != nil {
+		if cerr := q.createEventInvitationCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventInvitationCodeStmt: %w", cerr)
+		}
+	}
 	if q.createEventParticipantStmt != nil {
 		if cerr := q.createEventParticipantStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createEventParticipantStmt: %w", cerr)
 		}
 	}
+	if q.createEventScoreAdjustmentStmt != nil {
+		if cerr := q.createEventScoreAdjustmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventScoreAdjustmentStmt: %w", cerr)
+		}
+	}
 	if q.createEventTeamChallengeStmt != nil {
 		if cerr := q.createEventTeamChallengeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createEventTeamChallengeStmt: %w", cerr)
 		}
 	}
+	if q.createEventTeamHintUnlockStmt != nil {
+		if cerr := q.createEventTeamHintUnlockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventTeamHintUnlockStmt: %w", cerr)
+		}
+	}
+	if q.createEventTeamLabChallengeStmt != nil {
+		if cerr := q.createEventTeamLabChallengeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEventTeamLabChallengeStmt: %w", cerr)
+		}
+	}
 	if q.createExerciseStmt != nil {
 		if cerr := q.createExerciseStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createExerciseStmt: %w", cerr)
@@ -285,6 +546,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createFileStmt: %w", cerr)
 		}
 	}
+	if q.createJobStmt != nil {
+		if cerr := q.createJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createJobStmt: %w", cerr)
+		}
+	}
 	if q.createTeamInEventStmt != nil {
 		if cerr := q.createTeamInEventStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createTeamInEventStmt: %w", cerr)
@@ -310,11 +576,71 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteEventChallengeCategoryStmt: %w", cerr)
 		}
 	}
+	if q.deleteEventChallengePrerequisitesStmt != nil {
+		if cerr := q.deleteEventChallengePrerequisitesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventChallengePrerequisitesStmt: %w", cerr)
+		}
+	}
 	if q.deleteEventChallengesStmt != nil {
 		if cerr := q.deleteEventChallengesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteEventChallengesStmt: %w", cerr)
 		}
 	}
+	if q.deleteEventInvitationStmt != nil {
+		if cerr := q.deleteEventInvitationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventInvitationStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventInvitationCodeStmt != nil {
+		if cerr := q.deleteEventInvitationCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventInvitationCodeStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventLabChallengesStmt != nil {
+		if cerr := q.deleteEventLabChallengesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventLabChallengesStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventParticipantStmt != nil {
+		if cerr := q.deleteEventParticipantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventParticipantStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventScoreAdjustmentStmt != nil {
+		if cerr := q.deleteEventScoreAdjustmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventScoreAdjustmentStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventTeamStmt != nil {
+		if cerr := q.deleteEventTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventTeamStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventTeamChallengeStmt != nil {
+		if cerr := q.deleteEventTeamChallengeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventTeamChallengeStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventTeamLabChallengeStmt != nil {
+		if cerr := q.deleteEventTeamLabChallengeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventTeamLabChallengeStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventTeamLabChallengesStmt != nil {
+		if cerr := q.deleteEventTeamLabChallengesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventTeamLabChallengesStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventTeamsLabChallengesStmt != nil {
+		if cerr := q.deleteEventTeamsLabChallengesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventTeamsLabChallengesStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventTeamsLaboratoriesStmt != nil {
+		if cerr := q.deleteEventTeamsLaboratoriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventTeamsLaboratoriesStmt: %w", cerr)
+		}
+	}
 	if q.deleteExerciseStmt != nil {
 		if cerr := q.deleteExerciseStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteExerciseStmt: %w", cerr)
@@ -330,6 +656,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteFileStmt: %w", cerr)
 		}
 	}
+	if q.deleteJobStmt != nil {
+		if cerr := q.deleteJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteJobStmt: %w", cerr)
+		}
+	}
+	if q.deleteJobByKeyStmt != nil {
+		if cerr := q.deleteJobByKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteJobByKeyStmt: %w", cerr)
+		}
+	}
 	if q.deleteTemporalCodeStmt != nil {
 		if cerr := q.deleteTemporalCodeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteTemporalCodeStmt: %w", cerr)
@@ -345,6 +681,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing doesUserExistByIDStmt: %w", cerr)
 		}
 	}
+	if q.eventInvitationCodeValidStmt != nil {
+		if cerr := q.eventInvitationCodeValidStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing eventInvitationCodeValidStmt: %w", cerr)
+		}
+	}
+	if q.eventInvitationExistsStmt != nil {
+		if cerr := q.eventInvitationExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing eventInvitationExistsStmt: %w", cerr)
+		}
+	}
+	if q.eventTeamActivityExistsStmt != nil {
+		if cerr := q.eventTeamActivityExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing eventTeamActivityExistsStmt: %w", cerr)
+		}
+	}
+	if q.eventTeamCheatingIncidentExistsStmt != nil {
+		if cerr := q.eventTeamCheatingIncidentExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing eventTeamCheatingIncidentExistsStmt: %w", cerr)
+		}
+	}
 	if q.getAllChallengesSolutionsInEventStmt != nil {
 		if cerr := q.getAllChallengesSolutionsInEventStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllChallengesSolutionsInEventStmt: %w", cerr)
@@ -365,6 +721,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getChallengeFlagStmt: %w", cerr)
 		}
 	}
+	if q.getChallengeFlagOwnerTeamIDStmt != nil {
+		if cerr := q.getChallengeFlagOwnerTeamIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getChallengeFlagOwnerTeamIDStmt: %w", cerr)
+		}
+	}
 	if q.getEmailTemplateBodyStmt != nil {
 		if cerr := q.getEmailTemplateBodyStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEmailTemplateBodyStmt: %w", cerr)
@@ -400,6 +761,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getEventChallengesStmt: %w", cerr)
 		}
 	}
+	if q.getEventChallengesHintsStmt != nil {
+		if cerr := q.getEventChallengesHintsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventChallengesHintsStmt: %w", cerr)
+		}
+	}
+	if q.getEventChallengesPrerequisiteChallengesStmt != nil {
+		if cerr := q.getEventChallengesPrerequisiteChallengesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventChallengesPrerequisiteChallengesStmt: %w", cerr)
+		}
+	}
+	if q.getEventChallengesPrerequisitesStmt != nil {
+		if cerr := q.getEventChallengesPrerequisitesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventChallengesPrerequisitesStmt: %w", cerr)
+		}
+	}
+	if q.getEventCheatingIncidentsStmt != nil {
+		if cerr := q.getEventCheatingIncidentsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventCheatingIncidentsStmt: %w", cerr)
+		}
+	}
+	if q.getEventHintUnlocksStmt != nil {
+		if cerr := q.getEventHintUnlocksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventHintUnlocksStmt: %w", cerr)
+		}
+	}
 	if q.getEventIDIfNotWithdrawnStmt != nil {
 		if cerr := q.getEventIDIfNotWithdrawnStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEventIDIfNotWithdrawnStmt: %w", cerr)
@@ -410,6 +796,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getEventIDIfRunningStmt: %w", cerr)
 		}
 	}
+	if q.getEventInvitationCodesStmt != nil {
+		if cerr := q.getEventInvitationCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventInvitationCodesStmt: %w", cerr)
+		}
+	}
+	if q.getEventInvitationsStmt != nil {
+		if cerr := q.getEventInvitationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventInvitationsStmt: %w", cerr)
+		}
+	}
 	if q.getEventJoinStatusStmt != nil {
 		if cerr := q.getEventJoinStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEventJoinStatusStmt: %w", cerr)
@@ -425,16 +821,61 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getEventParticipantTeamIDStmt: %w", cerr)
 		}
 	}
+	if q.getEventParticipantsStmt != nil {
+		if cerr := q.getEventParticipantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventParticipantsStmt: %w", cerr)
+		}
+	}
+	if q.getEventParticipantsUserIDsStmt != nil {
+		if cerr := q.getEventParticipantsUserIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventParticipantsUserIDsStmt: %w", cerr)
+		}
+	}
+	if q.getEventScoreAdjustmentsStmt != nil {
+		if cerr := q.getEventScoreAdjustmentsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventScoreAdjustmentsStmt: %w", cerr)
+		}
+	}
+	if q.getEventTeamByIDStmt != nil {
+		if cerr := q.getEventTeamByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventTeamByIDStmt: %w", cerr)
+		}
+	}
 	if q.getEventTeamByNameStmt != nil {
 		if cerr := q.getEventTeamByNameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEventTeamByNameStmt: %w", cerr)
 		}
 	}
+	if q.getEventTeamChallengesStmt != nil {
+		if cerr := q.getEventTeamChallengesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventTeamChallengesStmt: %w", cerr)
+		}
+	}
+	if q.getEventTeamLabChallengesStmt != nil {
+		if cerr := q.getEventTeamLabChallengesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventTeamLabChallengesStmt: %w", cerr)
+		}
+	}
+	if q.getEventTeamMembersStmt != nil {
+		if cerr := q.getEventTeamMembersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventTeamMembersStmt: %w", cerr)
+		}
+	}
+	if q.getEventTeamUnlockedHintIDsStmt != nil {
+		if cerr := q.getEventTeamUnlockedHintIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventTeamUnlockedHintIDsStmt: %w", cerr)
+		}
+	}
 	if q.getEventTeamsStmt != nil {
 		if cerr := q.getEventTeamsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEventTeamsStmt: %w", cerr)
 		}
 	}
+	if q.getEventWaitlistedParticipantsStmt != nil {
+		if cerr := q.getEventWaitlistedParticipantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEventWaitlistedParticipantsStmt: %w", cerr)
+		}
+	}
 	if q.getExerciseByIDStmt != nil {
 		if cerr := q.getExerciseByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getExerciseByIDStmt: %w", cerr)
@@ -460,6 +901,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getFileByIDStmt: %w", cerr)
 		}
 	}
+	if q.getJobByKeyStmt != nil {
+		if cerr := q.getJobByKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getJobByKeyStmt: %w", cerr)
+		}
+	}
+	if q.getJobsByStatusStmt != nil {
+		if cerr := q.getJobsByStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getJobsByStatusStmt: %w", cerr)
+		}
+	}
+	if q.getTeamChallengeSolutionAttemptTimesStmt != nil {
+		if cerr := q.getTeamChallengeSolutionAttemptTimesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTeamChallengeSolutionAttemptTimesStmt: %w", cerr)
+		}
+	}
+	if q.getTeamSolvedChallengeIDsInEventStmt != nil {
+		if cerr := q.getTeamSolvedChallengeIDsInEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTeamSolvedChallengeIDsInEventStmt: %w", cerr)
+		}
+	}
 	if q.getTeamsSolvedChallengeInEventStmt != nil {
 		if cerr := q.getTeamsSolvedChallengeInEventStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTeamsSolvedChallengeInEventStmt: %w", cerr)
@@ -480,11 +941,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
 		}
 	}
+	if q.getUserInvitedEventIDsStmt != nil {
+		if cerr := q.getUserInvitedEventIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserInvitedEventIDsStmt: %w", cerr)
+		}
+	}
 	if q.getUsersWithSimilarStmt != nil {
 		if cerr := q.getUsersWithSimilarStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUsersWithSimilarStmt: %w", cerr)
 		}
 	}
+	if q.releaseEventInvitationCodeUseStmt != nil {
+		if cerr := q.releaseEventInvitationCodeUseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseEventInvitationCodeUseStmt: %w", cerr)
+		}
+	}
 	if q.setLastSeenStmt != nil {
 		if cerr := q.setLastSeenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setLastSeenStmt: %w", cerr)
@@ -510,11 +981,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateEventChallengeCategoryOrderStmt: %w", cerr)
 		}
 	}
+	if q.updateEventChallengeCategoryVisibilityStmt != nil {
+		if cerr := q.updateEventChallengeCategoryVisibilityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventChallengeCategoryVisibilityStmt: %w", cerr)
+		}
+	}
 	if q.updateEventChallengeOrderStmt != nil {
 		if cerr := q.updateEventChallengeOrderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateEventChallengeOrderStmt: %w", cerr)
 		}
 	}
+	if q.updateEventChallengeReleaseTimeStmt != nil {
+		if cerr := q.updateEventChallengeReleaseTimeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventChallengeReleaseTimeStmt: %w", cerr)
+		}
+	}
+	if q.updateEventChallengeScoringStmt != nil {
+		if cerr := q.updateEventChallengeScoringStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventChallengeScoringStmt: %w", cerr)
+		}
+	}
+	if q.updateEventChallengeVisibilityStmt != nil {
+		if cerr := q.updateEventChallengeVisibilityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventChallengeVisibilityStmt: %w", cerr)
+		}
+	}
 	if q.updateEventParticipantStatusStmt != nil {
 		if cerr := q.updateEventParticipantStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateEventParticipantStatusStmt: %w", cerr)
@@ -525,6 +1016,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateEventParticipantTeamStmt: %w", cerr)
 		}
 	}
+	if q.updateEventScoreAdjustmentStmt != nil {
+		if cerr := q.updateEventScoreAdjustmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventScoreAdjustmentStmt: %w", cerr)
+		}
+	}
+	if q.updateEventScoreboardRevealedStmt != nil {
+		if cerr := q.updateEventScoreboardRevealedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventScoreboardRevealedStmt: %w", cerr)
+		}
+	}
+	if q.updateEventTeamCaptainStmt != nil {
+		if cerr := q.updateEventTeamCaptainStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventTeamCaptainStmt: %w", cerr)
+		}
+	}
+	if q.updateEventTeamDisqualifiedStmt != nil {
+		if cerr := q.updateEventTeamDisqualifiedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventTeamDisqualifiedStmt: %w", cerr)
+		}
+	}
+	if q.updateEventTeamJoinCodeStmt != nil {
+		if cerr := q.updateEventTeamJoinCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventTeamJoinCodeStmt: %w", cerr)
+		}
+	}
+	if q.updateEventTeamLaboratoryStmt != nil {
+		if cerr := q.updateEventTeamLaboratoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventTeamLaboratoryStmt: %w", cerr)
+		}
+	}
+	if q.updateEventTeamNameStmt != nil {
+		if cerr := q.updateEventTeamNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEventTeamNameStmt: %w", cerr)
+		}
+	}
 	if q.updateExerciseStmt != nil {
 		if cerr := q.updateExerciseStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateExerciseStmt: %w", cerr)
@@ -535,6 +1061,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateExerciseCategoryStmt: %w", cerr)
 		}
 	}
+	if q.updateJobAttemptStmt != nil {
+		if cerr := q.updateJobAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateJobAttemptStmt: %w", cerr)
+		}
+	}
 	if q.updateUserEmailStmt != nil {
 		if cerr := q.updateUserEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUserEmailStmt: %w", cerr)
@@ -565,6 +1096,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateUserRoleStmt: %w", cerr)
 		}
 	}
+	if q.useEventInvitationCodeStmt != nil {
+		if cerr := q.useEventInvitationCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useEventInvitationCodeStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -602,147 +1138,281 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                      DBTX
-	tx                                      *sql.Tx
-	countChallengesInEventsStmt             *sql.Stmt
-	countTeamsInEventsStmt                  *sql.Stmt
-	createEventStmt                         *sql.Stmt
-	createEventChallengeStmt                *sql.Stmt
-	createEventChallengeCategoryStmt        *sql.Stmt
-	createEventChallengeSolutionAttemptStmt *sql.Stmt
-	createEventParticipantStmt              *sql.Stmt
-	createEventTeamChallengeStmt            *sql.Stmt
-	createExerciseStmt                      *sql.Stmt
-	createExerciseCategoryStmt              *sql.Stmt
-	createFileStmt                          *sql.Stmt
-	createTeamInEventStmt                   *sql.Stmt
-	createTemporalCodeStmt                  *sql.Stmt
-	createUserStmt                          *sql.Stmt
-	deleteEventStmt                         *sql.Stmt
-	deleteEventChallengeCategoryStmt        *sql.Stmt
-	deleteEventChallengesStmt               *sql.Stmt
-	deleteExerciseStmt                      *sql.Stmt
-	deleteExerciseCategoryStmt              *sql.Stmt
-	deleteFileStmt                          *sql.Stmt
-	deleteTemporalCodeStmt                  *sql.Stmt
-	deleteUserStmt                          *sql.Stmt
-	doesUserExistByIDStmt                   *sql.Stmt
-	getAllChallengesSolutionsInEventStmt    *sql.Stmt
-	getAllEventsStmt                        *sql.Stmt
-	getAllUsersStmt                         *sql.Stmt
-	getChallengeFlagStmt                    *sql.Stmt
-	getEmailTemplateBodyStmt                *sql.Stmt
-	getEmailTemplateSubjectStmt             *sql.Stmt
-	getEventByIDStmt                        *sql.Stmt
-	getEventByTagStmt                       *sql.Stmt
-	getEventChallengeByIDStmt               *sql.Stmt
-	getEventChallengeCategoriesStmt         *sql.Stmt
-	getEventChallengesStmt                  *sql.Stmt
-	getEventIDIfNotWithdrawnStmt            *sql.Stmt
-	getEventIDIfRunningStmt                 *sql.Stmt
-	getEventJoinStatusStmt                  *sql.Stmt
-	getEventParticipantTeamStmt             *sql.Stmt
-	getEventParticipantTeamIDStmt           *sql.Stmt
-	getEventTeamByNameStmt                  *sql.Stmt
-	getEventTeamsStmt                       *sql.Stmt
-	getExerciseByIDStmt                     *sql.Stmt
-	getExerciseCategoriesStmt               *sql.Stmt
-	getExercisesStmt                        *sql.Stmt
-	getExercisesByCategoryStmt              *sql.Stmt
-	getFileByIDStmt                         *sql.Stmt
-	getTeamsSolvedChallengeInEventStmt      *sql.Stmt
-	getTemporalCodeStmt                     *sql.Stmt
-	getUserByEmailStmt                      *sql.Stmt
-	getUserByIDStmt                         *sql.Stmt
-	getUsersWithSimilarStmt                 *sql.Stmt
-	setLastSeenStmt                         *sql.Stmt
-	teamExistsInEventStmt                   *sql.Stmt
-	updateEventStmt                         *sql.Stmt
-	updateEventChallengeCategoryStmt        *sql.Stmt
-	updateEventChallengeCategoryOrderStmt   *sql.Stmt
-	updateEventChallengeOrderStmt           *sql.Stmt
-	updateEventParticipantStatusStmt        *sql.Stmt
-	updateEventParticipantTeamStmt          *sql.Stmt
-	updateExerciseStmt                      *sql.Stmt
-	updateExerciseCategoryStmt              *sql.Stmt
-	updateUserEmailStmt                     *sql.Stmt
-	updateUserGoogleIDStmt                  *sql.Stmt
-	updateUserNameStmt                      *sql.Stmt
-	updateUserPasswordStmt                  *sql.Stmt
-	updateUserPictureStmt                   *sql.Stmt
-	updateUserRoleStmt                      *sql.Stmt
+	db                                            DBTX
+	tx                                            *sql.Tx
+	countChallengesInEventsStmt                   *sql.Stmt
+	countEventActiveParticipantsStmt              *sql.Stmt
+	countEventTeamMembersStmt                     *sql.Stmt
+	countEventTeamsStmt                           *sql.Stmt
+	countTeamsInEventsStmt                        *sql.Stmt
+	createEventStmt                               *sql.Stmt
+	createEventChallengeStmt                      *sql.Stmt
+	createEventChallengeCategoryStmt              *sql.Stmt
+	createEventChallengeHintStmt                  *sql.Stmt
+	createEventChallengePrerequisiteStmt          *sql.Stmt
+	createEventChallengePrerequisiteChallengeStmt *sql.Stmt
+	createEventChallengeSolutionAttemptStmt       *sql.Stmt
+	createEventCheatingIncidentStmt               *sql.Stmt
+	createEventInvitationStmt                     *sql.Stmt
+	createEventInvitationCodeStmt                 *sql.Stmt
+	createEventParticipantStmt                    *sql.Stmt
+	createEventScoreAdjustmentStmt                *sql.Stmt
+	createEventTeamChallengeStmt                  *sql.Stmt
+	createEventTeamHintUnlockStmt                 *sql.Stmt
+	createEventTeamLabChallengeStmt               *sql.Stmt
+	createExerciseStmt                            *sql.Stmt
+	createExerciseCategoryStmt                    *sql.Stmt
+	createFileStmt                                *sql.Stmt
+	createJobStmt                                 *sql.Stmt
+	createTeamInEventStmt                         *sql.Stmt
+	createTemporalCodeStmt                        *sql.Stmt
+	createUserStmt                                *sql.Stmt
+	deleteEventStmt                               *sql.Stmt
+	deleteEventChallengeCategoryStmt              *sql.Stmt
+	deleteEventChallengePrerequisitesStmt         *sql.Stmt
+	deleteEventChallengesStmt                     *sql.Stmt
+	deleteEventInvitationStmt                     *sql.Stmt
+	deleteEventInvitationCodeStmt                 *sql.Stmt
+	deleteEventLabChallengesStmt                  *sql.Stmt
+	deleteEventParticipantStmt                    *sql.Stmt
+	deleteEventScoreAdjustmentStmt                *sql.Stmt
+	deleteEventTeamStmt                           *sql.Stmt
+	deleteEventTeamChallengeStmt                  *sql.Stmt
+	deleteEventTeamLabChallengeStmt               *sql.Stmt
+	deleteEventTeamLabChallengesStmt              *sql.Stmt
+	deleteEventTeamsLabChallengesStmt             *sql.Stmt
+	deleteEventTeamsLaboratoriesStmt              *sql.Stmt
+	deleteExerciseStmt                            *sql.Stmt
+	deleteExerciseCategoryStmt                    *sql.Stmt
+	deleteFileStmt                                *sql.Stmt
+	deleteJobStmt                                 *sql.Stmt
+	deleteJobByKeyStmt                            *sql.Stmt
+	deleteTemporalCodeStmt                        *sql.Stmt
+	deleteUserStmt                                *sql.Stmt
+	doesUserExistByIDStmt                         *sql.Stmt
+	eventInvitationCodeValidStmt                  *sql.Stmt
+	eventInvitationExistsStmt                     *sql.Stmt
+	eventTeamActivityExistsStmt                   *sql.Stmt
+	eventTeamCheatingIncidentExistsStmt           *sql.Stmt
+	getAllChallengesSolutionsInEventStmt          *sql.Stmt
+	getAllEventsStmt                              *sql.Stmt
+	getAllUsersStmt                               *sql.Stmt
+	getChallengeFlagStmt                          *sql.Stmt
+	getChallengeFlagOwnerTeamIDStmt               *sql.Stmt
+	getEmailTemplateBodyStmt                      *sql.Stmt
+	getEmailTemplateSubjectStmt                   *sql.Stmt
+	getEventByIDStmt                              *sql.Stmt
+	getEventByTagStmt                             *sql.Stmt
+	getEventChallengeByIDStmt                     *sql.Stmt
+	getEventChallengeCategoriesStmt               *sql.Stmt
+	getEventChallengesStmt                        *sql.Stmt
+	getEventChallengesHintsStmt                   *sql.Stmt
+	getEventChallengesPrerequisiteChallengesStmt  *sql.Stmt
+	getEventChallengesPrerequisitesStmt           *sql.Stmt
+	getEventCheatingIncidentsStmt                 *sql.Stmt
+	getEventHintUnlocksStmt                       *sql.Stmt
+	getEventIDIfNotWithdrawnStmt                  *sql.Stmt
+	getEventIDIfRunningStmt                       *sql.Stmt
+	getEventInvitationCodesStmt                   *sql.Stmt
+	getEventInvitationsStmt                       *sql.Stmt
+	getEventJoinStatusStmt                        *sql.Stmt
+	getEventParticipantTeamStmt                   *sql.Stmt
+	getEventParticipantTeamIDStmt                 *sql.Stmt
+	getEventParticipantsStmt                      *sql.Stmt
+	getEventParticipantsUserIDsStmt               *sql.Stmt
+	getEventScoreAdjustmentsStmt                  *sql.Stmt
+	getEventTeamByIDStmt                          *sql.Stmt
+	getEventTeamByNameStmt                        *sql.Stmt
+	getEventTeamChallengesStmt                    *sql.Stmt
+	getEventTeamLabChallengesStmt                 *sql.Stmt
+	getEventTeamMembersStmt                       *sql.Stmt
+	getEventTeamUnlockedHintIDsStmt               *sql.Stmt
+	getEventTeamsStmt                             *sql.Stmt
+	getEventWaitlistedParticipantsStmt            *sql.Stmt
+	getExerciseByIDStmt                           *sql.Stmt
+	getExerciseCategoriesStmt                     *sql.Stmt
+	getExercisesStmt                              *sql.Stmt
+	getExercisesByCategoryStmt                    *sql.Stmt
+	getFileByIDStmt                               *sql.Stmt
+	getJobByKeyStmt                               *sql.Stmt
+	getJobsByStatusStmt                           *sql.Stmt
+	getTeamChallengeSolutionAttemptTimesStmt      *sql.Stmt
+	getTeamSolvedChallengeIDsInEventStmt          *sql.Stmt
+	getTeamsSolvedChallengeInEventStmt            *sql.Stmt
+	getTemporalCodeStmt                           *sql.Stmt
+	getUserByEmailStmt                            *sql.Stmt
+	getUserByIDStmt                               *sql.Stmt
+	getUserInvitedEventIDsStmt                    *sql.Stmt
+	getUsersWithSimilarStmt                       *sql.Stmt
+	releaseEventInvitationCodeUseStmt             *sql.Stmt
+	setLastSeenStmt                               *sql.Stmt
+	teamExistsInEventStmt                         *sql.Stmt
+	updateEventStmt                               *sql.Stmt
+	updateEventChallengeCategoryStmt              *sql.Stmt
+	updateEventChallengeCategoryOrderStmt         *sql.Stmt
+	updateEventChallengeCategoryVisibilityStmt    *sql.Stmt
+	updateEventChallengeOrderStmt                 *sql.Stmt
+	updateEventChallengeReleaseTimeStmt           *sql.Stmt
+	updateEventChallengeScoringStmt               *sql.Stmt
+	updateEventChallengeVisibilityStmt            *sql.Stmt
+	updateEventParticipantStatusStmt              *sql.Stmt
+	updateEventParticipantTeamStmt                *sql.Stmt
+	updateEventScoreAdjustmentStmt                *sql.Stmt
+	updateEventScoreboardRevealedStmt             *sql.Stmt
+	updateEventTeamCaptainStmt                    *sql.Stmt
+	updateEventTeamDisqualifiedStmt               *sql.Stmt
+	updateEventTeamJoinCodeStmt                   *sql.Stmt
+	updateEventTeamLaboratoryStmt                 *sql.Stmt
+	updateEventTeamNameStmt                       *sql.Stmt
+	updateExerciseStmt                            *sql.Stmt
+	updateExerciseCategoryStmt                    *sql.Stmt
+	updateJobAttemptStmt                          *sql.Stmt
+	updateUserEmailStmt                           *sql.Stmt
+	updateUserGoogleIDStmt                        *sql.Stmt
+	updateUserNameStmt                            *sql.Stmt
+	updateUserPasswordStmt                        *sql.Stmt
+	updateUserPictureStmt                         *sql.Stmt
+	updateUserRoleStmt                            *sql.Stmt
+	useEventInvitationCodeStmt                    *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                      tx,
-		tx:                                      tx,
-		countChallengesInEventsStmt:             q.countChallengesInEventsStmt,
-		countTeamsInEventsStmt:                  q.countTeamsInEventsStmt,
-		createEventStmt:                         q.createEventStmt,
-		createEventChallengeStmt:                q.createEventChallengeStmt,
-		createEventChallengeCategoryStmt:        q.createEventChallengeCategoryStmt,
-		createEventChallengeSolutionAttemptStmt: q.createEventChallengeSolutionAttemptStmt,
-		createEventParticipantStmt:              q.createEventParticipantStmt,
-		createEventTeamChallengeStmt:            q.createEventTeamChallengeStmt,
-		createExerciseStmt:                      q.createExerciseStmt,
-		createExerciseCategoryStmt:              q.createExerciseCategoryStmt,
-		createFileStmt:                          q.createFileStmt,
-		createTeamInEventStmt:                   q.createTeamInEventStmt,
-		createTemporalCodeStmt:                  q.createTemporalCodeStmt,
-		createUserStmt:                          q.createUserStmt,
-		deleteEventStmt:                         q.deleteEventStmt,
-		deleteEventChallengeCategoryStmt:        q.deleteEventChallengeCategoryStmt,
-		deleteEventChallengesStmt:               q.deleteEventChallengesStmt,
-		deleteExerciseStmt:                      q.deleteExerciseStmt,
-		deleteExerciseCategoryStmt:              q.deleteExerciseCategoryStmt,
-		deleteFileStmt:                          q.deleteFileStmt,
-		deleteTemporalCodeStmt:                  q.deleteTemporalCodeStmt,
-		deleteUserStmt:                          q.deleteUserStmt,
-		doesUserExistByIDStmt:                   q.doesUserExistByIDStmt,
-		getAllChallengesSolutionsInEventStmt:    q.getAllChallengesSolutionsInEventStmt,
-		getAllEventsStmt:                        q.getAllEventsStmt,
-		getAllUsersStmt:                         q.getAllUsersStmt,
-		getChallengeFlagStmt:                    q.getChallengeFlagStmt,
-		getEmailTemplateBodyStmt:                q.getEmailTemplateBodyStmt,
-		getEmailTemplateSubjectStmt:             q.getEmailTemplateSubjectStmt,
-		getEventByIDStmt:                        q.getEventByIDStmt,
-		getEventByTagStmt:                       q.getEventByTagStmt,
-		getEventChallengeByIDStmt:               q.getEventChallengeByIDStmt,
-		getEventChallengeCategoriesStmt:         q.getEventChallengeCategoriesStmt,
-		getEventChallengesStmt:                  q.getEventChallengesStmt,
-		getEventIDIfNotWithdrawnStmt:            q.getEventIDIfNotWithdrawnStmt,
-		getEventIDIfRunningStmt:                 q.getEventIDIfRunningStmt,
-		getEventJoinStatusStmt:                  q.getEventJoinStatusStmt,
-		getEventParticipantTeamStmt:             q.getEventParticipantTeamStmt,
-		getEventParticipantTeamIDStmt:           q.getEventParticipantTeamIDStmt,
-		getEventTeamByNameStmt:                  q.getEventTeamByNameStmt,
-		getEventTeamsStmt:                       q.getEventTeamsStmt,
-		getExerciseByIDStmt:                     q.getExerciseByIDStmt,
-		getExerciseCategoriesStmt:               q.getExerciseCategoriesStmt,
-		getExercisesStmt:                        q.getExercisesStmt,
-		getExercisesByCategoryStmt:              q.getExercisesByCategoryStmt,
-		getFileByIDStmt:                         q.getFileByIDStmt,
-		getTeamsSolvedChallengeInEventStmt:      q.getTeamsSolvedChallengeInEventStmt,
-		getTemporalCodeStmt:                     q.getTemporalCodeStmt,
-		getUserByEmailStmt:                      q.getUserByEmailStmt,
-		getUserByIDStmt:                         q.getUserByIDStmt,
-		getUsersWithSimilarStmt:                 q.getUsersWithSimilarStmt,
-		setLastSeenStmt:                         q.setLastSeenStmt,
-		teamExistsInEventStmt:                   q.teamExistsInEventStmt,
-		updateEventStmt:                         q.updateEventStmt,
-		updateEventChallengeCategoryStmt:        q.updateEventChallengeCategoryStmt,
-		updateEventChallengeCategoryOrderStmt:   q.updateEventChallengeCategoryOrderStmt,
-		updateEventChallengeOrderStmt:           q.updateEventChallengeOrderStmt,
-		updateEventParticipantStatusStmt:        q.updateEventParticipantStatusStmt,
-		updateEventParticipantTeamStmt:          q.updateEventParticipantTeamStmt,
-		updateExerciseStmt:                      q.updateExerciseStmt,
-		updateExerciseCategoryStmt:              q.updateExerciseCategoryStmt,
-		updateUserEmailStmt:                     q.updateUserEmailStmt,
-		updateUserGoogleIDStmt:                  q.updateUserGoogleIDStmt,
-		updateUserNameStmt:                      q.updateUserNameStmt,
-		updateUserPasswordStmt:                  q.updateUserPasswordStmt,
-		updateUserPictureStmt:                   q.updateUserPictureStmt,
-		updateUserRoleStmt:                      q.updateUserRoleStmt,
+		db:                                   tx,
+		tx:                                   tx,
+		countChallengesInEventsStmt:          q.countChallengesInEventsStmt,
+		countEventActiveParticipantsStmt:     q.countEventActiveParticipantsStmt,
+		countEventTeamMembersStmt:            q.countEventTeamMembersStmt,
+		countEventTeamsStmt:                  q.countEventTeamsStmt,
+		countTeamsInEventsStmt:               q.countTeamsInEventsStmt,
+		createEventStmt:                      q.createEventStmt,
+		createEventChallengeStmt:             q.createEventChallengeStmt,
+		createEventChallengeCategoryStmt:     q.createEventChallengeCategoryStmt,
+		createEventChallengeHintStmt:         q.createEventChallengeHintStmt,
+		createEventChallengePrerequisiteStmt: q.createEventChallengePrerequisiteStmt,
+		createEventChallengePrerequisiteChallengeStmt: q.createEventChallengePrerequisiteChallengeStmt,
+		createEventChallengeSolutionAttemptStmt:       q.createEventChallengeSolutionAttemptStmt,
+		createEventCheatingIncidentStmt:               q.createEventCheatingIncidentStmt,
+		createEventInvitationStmt:                     q.createEventInvitationStmt,
+		createEventInvitationCodeStmt:                 q.createEventInvitationCodeStmt,
+		createEventParticipantStmt:                    q.createEventParticipantStmt,
+		createEventScoreAdjustmentStmt:                q.createEventScoreAdjustmentStmt,
+		createEventTeamChallengeStmt:                  q.createEventTeamChallengeStmt,
+		createEventTeamHintUnlockStmt:                 q.createEventTeamHintUnlockStmt,
+		createEventTeamLabChallengeStmt:               q.createEventTeamLabChallengeStmt,
+		createExerciseStmt:                            q.createExerciseStmt,
+		createExerciseCategoryStmt:                    q.createExerciseCategoryStmt,
+		createFileStmt:                                q.createFileStmt,
+		createJobStmt:                                 q.createJobStmt,
+		createTeamInEventStmt:                         q.createTeamInEventStmt,
+		createTemporalCodeStmt:                        q.createTemporalCodeStmt,
+		createUserStmt:                                q.createUserStmt,
+		deleteEventStmt:                               q.deleteEventStmt,
+		deleteEventChallengeCategoryStmt:              q.deleteEventChallengeCategoryStmt,
+		deleteEventChallengePrerequisitesStmt:         q.deleteEventChallengePrerequisitesStmt,
+		deleteEventChallengesStmt:                     q.deleteEventChallengesStmt,
+		deleteEventInvitationStmt:                     q.deleteEventInvitationStmt,
+		deleteEventInvitationCodeStmt:                 q.deleteEventInvitationCodeStmt,
+		deleteEventLabChallengesStmt:                  q.deleteEventLabChallengesStmt,
+		deleteEventParticipantStmt:                    q.deleteEventParticipantStmt,
+		deleteEventScoreAdjustmentStmt:                q.deleteEventScoreAdjustmentStmt,
+		deleteEventTeamStmt:                           q.deleteEventTeamStmt,
+		deleteEventTeamChallengeStmt:                  q.deleteEventTeamChallengeStmt,
+		deleteEventTeamLabChallengeStmt:               q.deleteEventTeamLabChallengeStmt,
+		deleteEventTeamLabChallengesStmt:              q.deleteEventTeamLabChallengesStmt,
+		deleteEventTeamsLabChallengesStmt:             q.deleteEventTeamsLabChallengesStmt,
+		deleteEventTeamsLaboratoriesStmt:              q.deleteEventTeamsLaboratoriesStmt,
+		deleteExerciseStmt:                            q.deleteExerciseStmt,
+		deleteExerciseCategoryStmt:                    q.deleteExerciseCategoryStmt,
+		deleteFileStmt:                                q.deleteFileStmt,
+		deleteJobStmt:                                 q.deleteJobStmt,
+		deleteJobByKeyStmt:                            q.deleteJobByKeyStmt,
+		deleteTemporalCodeStmt:                        q.deleteTemporalCodeStmt,
+		deleteUserStmt:                                q.deleteUserStmt,
+		doesUserExistByIDStmt:                         q.doesUserExistByIDStmt,
+		eventInvitationCodeValidStmt:                  q.eventInvitationCodeValidStmt,
+		eventInvitationExistsStmt:                     q.eventInvitationExistsStmt,
+		eventTeamActivityExistsStmt:                   q.eventTeamActivityExistsStmt,
+		eventTeamCheatingIncidentExistsStmt:           q.eventTeamCheatingIncidentExistsStmt,
+		getAllChallengesSolutionsInEventStmt:          q.getAllChallengesSolutionsInEventStmt,
+		getAllEventsStmt:                              q.getAllEventsStmt,
+		getAllUsersStmt:                               q.getAllUsersStmt,
+		getChallengeFlagStmt:                          q.getChallengeFlagStmt,
+		getChallengeFlagOwnerTeamIDStmt:               q.getChallengeFlagOwnerTeamIDStmt,
+		getEmailTemplateBodyStmt:                      q.getEmailTemplateBodyStmt,
+		getEmailTemplateSubjectStmt:                   q.getEmailTemplateSubjectStmt,
+		getEventByIDStmt:                              q.getEventByIDStmt,
+		getEventByTagStmt:                             q.getEventByTagStmt,
+		getEventChallengeByIDStmt:                     q.getEventChallengeByIDStmt,
+		getEventChallengeCategoriesStmt:               q.getEventChallengeCategoriesStmt,
+		getEventChallengesStmt:                        q.getEventChallengesStmt,
+		getEventChallengesHintsStmt:                   q.getEventChallengesHintsStmt,
+		getEventChallengesPrerequisiteChallengesStmt:  q.getEventChallengesPrerequisiteChallengesStmt,
+		getEventChallengesPrerequisitesStmt:           q.getEventChallengesPrerequisitesStmt,
+		getEventCheatingIncidentsStmt:                 q.getEventCheatingIncidentsStmt,
+		getEventHintUnlocksStmt:                       q.getEventHintUnlocksStmt,
+		getEventIDIfNotWithdrawnStmt:                  q.getEventIDIfNotWithdrawnStmt,
+		getEventIDIfRunningStmt:                       q.getEventIDIfRunningStmt,
+		getEventInvitationCodesStmt:                   q.getEventInvitationCodesStmt,
+		getEventInvitationsStmt:                       q.getEventInvitationsStmt,
+		getEventJoinStatusStmt:                        q.getEventJoinStatusStmt,
+		getEventParticipantTeamStmt:                   q.getEventParticipantTeamStmt,
+		getEventParticipantTeamIDStmt:                 q.getEventParticipantTeamIDStmt,
+		getEventParticipantsStmt:                      q.getEventParticipantsStmt,
+		getEventParticipantsUserIDsStmt:               q.getEventParticipantsUserIDsStmt,
+		getEventScoreAdjustmentsStmt:                  q.getEventScoreAdjustmentsStmt,
+		getEventTeamByIDStmt:                          q.getEventTeamByIDStmt,
+		getEventTeamByNameStmt:                        q.getEventTeamByNameStmt,
+		getEventTeamChallengesStmt:                    q.getEventTeamChallengesStmt,
+		getEventTeamLabChallengesStmt:                 q.getEventTeamLabChallengesStmt,
+		getEventTeamMembersStmt:                       q.getEventTeamMembersStmt,
+		getEventTeamUnlockedHintIDsStmt:               q.getEventTeamUnlockedHintIDsStmt,
+		getEventTeamsStmt:                             q.getEventTeamsStmt,
+		getEventWaitlistedParticipantsStmt:            q.getEventWaitlistedParticipantsStmt,
+		getExerciseByIDStmt:                           q.getExerciseByIDStmt,
+		getExerciseCategoriesStmt:                     q.getExerciseCategoriesStmt,
+		getExercisesStmt:                              q.getExercisesStmt,
+		getExercisesByCategoryStmt:                    q.getExercisesByCategoryStmt,
+		getFileByIDStmt:                               q.getFileByIDStmt,
+		getJobByKeyStmt:                               q.getJobByKeyStmt,
+		getJobsByStatusStmt:                           q.getJobsByStatusStmt,
+		getTeamChallengeSolutionAttemptTimesStmt:      q.getTeamChallengeSolutionAttemptTimesStmt,
+		getTeamSolvedChallengeIDsInEventStmt:          q.getTeamSolvedChallengeIDsInEventStmt,
+		getTeamsSolvedChallengeInEventStmt:            q.getTeamsSolvedChallengeInEventStmt,
+		getTemporalCodeStmt:                           q.getTemporalCodeStmt,
+		getUserByEmailStmt:                            q.getUserByEmailStmt,
+		getUserByIDStmt:                               q.getUserByIDStmt,
+		getUserInvitedEventIDsStmt:                    q.getUserInvitedEventIDsStmt,
+		getUsersWithSimilarStmt:                       q.getUsersWithSimilarStmt,
+		releaseEventInvitationCodeUseStmt:             q.releaseEventInvitationCodeUseStmt,
+		setLastSeenStmt:                               q.setLastSeenStmt,
+		teamExistsInEventStmt:                         q.teamExistsInEventStmt,
+		updateEventStmt:                               q.updateEventStmt,
+		updateEventChallengeCategoryStmt:              q.updateEventChallengeCategoryStmt,
+		updateEventChallengeCategoryOrderStmt:         q.updateEventChallengeCategoryOrderStmt,
+		updateEventChallengeCategoryVisibilityStmt:    q.updateEventChallengeCategoryVisibilityStmt,
+		updateEventChallengeOrderStmt:                 q.updateEventChallengeOrderStmt,
+		updateEventChallengeReleaseTimeStmt:           q.updateEventChallengeReleaseTimeStmt,
+		updateEventChallengeScoringStmt:               q.updateEventChallengeScoringStmt,
+		updateEventChallengeVisibilityStmt:            q.updateEventChallengeVisibilityStmt,
+		updateEventParticipantStatusStmt:              q.updateEventParticipantStatusStmt,
+		updateEventParticipantTeamStmt:                q.updateEventParticipantTeamStmt,
+		updateEventScoreAdjustmentStmt:                q.updateEventScoreAdjustmentStmt,
+		updateEventScoreboardRevealedStmt:             q.updateEventScoreboardRevealedStmt,
+		updateEventTeamCaptainStmt:                    q.updateEventTeamCaptainStmt,
+		updateEventTeamDisqualifiedStmt:               q.updateEventTeamDisqualifiedStmt,
+		updateEventTeamJoinCodeStmt:                   q.updateEventTeamJoinCodeStmt,
+		updateEventTeamLaboratoryStmt:                 q.updateEventTeamLaboratoryStmt,
+		updateEventTeamNameStmt:                       q.updateEventTeamNameStmt,
+		updateExerciseStmt:                            q.updateExerciseStmt,
+		updateExerciseCategoryStmt:                    q.updateExerciseCategoryStmt,
+		updateJobAttemptStmt:                          q.updateJobAttemptStmt,
+		updateUserEmailStmt:                           q.updateUserEmailStmt,
+		updateUserGoogleIDStmt:                        q.updateUserGoogleIDStmt,
+		updateUserNameStmt:                            q.updateUserNameStmt,
+		updateUserPasswordStmt:                        q.updateUserPasswordStmt,
+		updateUserPictureStmt:                         q.updateUserPictureStmt,
+		updateUserRoleStmt:                            q.updateUserRoleStmt,
+		useEventInvitationCodeStmt:                    q.useEventInvitationCodeStmt,
 	}
 }
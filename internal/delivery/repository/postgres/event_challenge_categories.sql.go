@@ -7,21 +7,23 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/gofrs/uuid"
 )
 
 const createEventChallengeCategory = `-- name: CreateEventChallengeCategory :exec
 insert into event_challenge_categories
-    (id, event_id, name, order_index)
-values ($1, $2, $3, $4)
+    (id, event_id, name, order_index, release_time)
+values ($1, $2, $3, $4, $5)
 `
 
 type CreateEventChallengeCategoryParams struct {
-	ID         uuid.UUID `json:"id"`
-	EventID    uuid.UUID `json:"event_id"`
-	Name       string    `json:"name"`
-	OrderIndex int32     `json:"order_index"`
+	ID          uuid.UUID    `json:"id"`
+	EventID     uuid.UUID    `json:"event_id"`
+	Name        string       `json:"name"`
+	OrderIndex  int32        `json:"order_index"`
+	ReleaseTime sql.NullTime `json:"release_time"`
 }
 
 func (q *Queries) CreateEventChallengeCategory(ctx context.Context, arg CreateEventChallengeCategoryParams) error {
@@ -30,6 +32,7 @@ func (q *Queries) CreateEventChallengeCategory(ctx context.Context, arg CreateEv
 		arg.EventID,
 		arg.Name,
 		arg.OrderIndex,
+		arg.ReleaseTime,
 	)
 	return err
 }
@@ -52,7 +55,7 @@ func (q *Queries) DeleteEventChallengeCategory(ctx context.Context, arg DeleteEv
 }
 
 const getEventChallengeCategories = `-- name: GetEventChallengeCategories :many
-select id, event_id, name, order_index, updated_at, updated_by, created_at
+select id, event_id, name, order_index, updated_at, updated_by, created_at, release_time, visibility
 from event_challenge_categories
 where event_id = $1
 order by order_index
@@ -75,6 +78,8 @@ func (q *Queries) GetEventChallengeCategories(ctx context.Context, eventID uuid.
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.CreatedAt,
+			&i.ReleaseTime,
+			&i.Visibility,
 		); err != nil {
 			return nil, err
 		}
@@ -91,19 +96,26 @@ func (q *Queries) GetEventChallengeCategories(ctx context.Context, eventID uuid.
 
 const updateEventChallengeCategory = `-- name: UpdateEventChallengeCategory :exec
 update event_challenge_categories
-set name = $3
+set name         = $3,
+    release_time = $4
 where id = $1
   and event_id = $2
 `
 
 type UpdateEventChallengeCategoryParams struct {
-	ID      uuid.UUID `json:"id"`
-	EventID uuid.UUID `json:"event_id"`
-	Name    string    `json:"name"`
+	ID          uuid.UUID    `json:"id"`
+	EventID     uuid.UUID    `json:"event_id"`
+	Name        string       `json:"name"`
+	ReleaseTime sql.NullTime `json:"release_time"`
 }
 
 func (q *Queries) UpdateEventChallengeCategory(ctx context.Context, arg UpdateEventChallengeCategoryParams) error {
-	_, err := q.exec(ctx, q.updateEventChallengeCategoryStmt, updateEventChallengeCategory, arg.ID, arg.EventID, arg.Name)
+	_, err := q.exec(ctx, q.updateEventChallengeCategoryStmt, updateEventChallengeCategory,
+		arg.ID,
+		arg.EventID,
+		arg.Name,
+		arg.ReleaseTime,
+	)
 	return err
 }
 
@@ -124,3 +136,21 @@ func (q *Queries) UpdateEventChallengeCategoryOrder(ctx context.Context, arg Upd
 	_, err := q.exec(ctx, q.updateEventChallengeCategoryOrderStmt, updateEventChallengeCategoryOrder, arg.ID, arg.EventID, arg.OrderIndex)
 	return err
 }
+
+const updateEventChallengeCategoryVisibility = `-- name: UpdateEventChallengeCategoryVisibility :exec
+update event_challenge_categories
+set visibility = $3
+where id = $1
+  and event_id = $2
+`
+
+type UpdateEventChallengeCategoryVisibilityParams struct {
+	ID         uuid.UUID `json:"id"`
+	EventID    uuid.UUID `json:"event_id"`
+	Visibility int32     `json:"visibility"`
+}
+
+func (q *Queries) UpdateEventChallengeCategoryVisibility(ctx context.Context, arg UpdateEventChallengeCategoryVisibilityParams) error {
+	_, err := q.exec(ctx, q.updateEventChallengeCategoryVisibilityStmt, updateEventChallengeCategoryVisibility, arg.ID, arg.EventID, arg.Visibility)
+	return err
+}
@@ -46,11 +46,13 @@ func (q *Queries) CreateEventChallengeSolutionAttempt(ctx context.Context, arg C
 }
 
 const getAllChallengesSolutionsInEvent = `-- name: GetAllChallengesSolutionsInEvent :many
-
 select challenge_id, team_id, participant_id, timestamp
-from event_challenge_solution_attempts
-where event_id = $1
-  and is_correct = true
+from (select distinct on (challenge_id, team_id) challenge_id, team_id, participant_id, timestamp
+      from event_challenge_solution_attempts
+      where event_id = $1
+        and is_correct = true
+      order by challenge_id, team_id, timestamp) as first_solutions
+order by timestamp
 `
 
 type GetAllChallengesSolutionsInEventRow struct {
@@ -60,13 +62,6 @@ type GetAllChallengesSolutionsInEventRow struct {
 	Timestamp     time.Time `json:"timestamp"`
 }
 
-// -- name: GetAllSolvedChallengesIDsByTeamInEvent :many
-// select challenge_id
-// from event_challenge_solution_attempts
-// where event_id = $1
-//
-//	and team_id = $2
-//	and is_correct = true;
 func (q *Queries) GetAllChallengesSolutionsInEvent(ctx context.Context, eventID uuid.UUID) ([]GetAllChallengesSolutionsInEventRow, error) {
 	rows, err := q.query(ctx, q.getAllChallengesSolutionsInEventStmt, getAllChallengesSolutionsInEvent, eventID)
 	if err != nil {
@@ -95,15 +90,92 @@ func (q *Queries) GetAllChallengesSolutionsInEvent(ctx context.Context, eventID
 	return items, nil
 }
 
-const getTeamsSolvedChallengeInEvent = `-- name: GetTeamsSolvedChallengeInEvent :many
-select t.id, t.name, participant_id, timestamp
+const getTeamChallengeSolutionAttemptTimes = `-- name: GetTeamChallengeSolutionAttemptTimes :many
+select timestamp
 from event_challenge_solution_attempts
-         inner join event_teams t on t.id = event_challenge_solution_attempts.team_id
-where t.event_id = $1
+where event_id = $1
   and challenge_id = $2
+  and team_id = $3
+order by timestamp desc
+`
+
+type GetTeamChallengeSolutionAttemptTimesParams struct {
+	EventID     uuid.UUID `json:"event_id"`
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	TeamID      uuid.UUID `json:"team_id"`
+}
+
+func (q *Queries) GetTeamChallengeSolutionAttemptTimes(ctx context.Context, arg GetTeamChallengeSolutionAttemptTimesParams) ([]time.Time, error) {
+	rows, err := q.query(ctx, q.getTeamChallengeSolutionAttemptTimesStmt, getTeamChallengeSolutionAttemptTimes, arg.EventID, arg.ChallengeID, arg.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []time.Time{}
+	for rows.Next() {
+		var timestamp time.Time
+		if err := rows.Scan(&timestamp); err != nil {
+			return nil, err
+		}
+		items = append(items, timestamp)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamSolvedChallengeIDsInEvent = `-- name: GetTeamSolvedChallengeIDsInEvent :many
+select distinct challenge_id
+from event_challenge_solution_attempts
+where event_id = $1
+  and team_id = $2
   and is_correct = true
 `
 
+type GetTeamSolvedChallengeIDsInEventParams struct {
+	EventID uuid.UUID `json:"event_id"`
+	TeamID  uuid.UUID `json:"team_id"`
+}
+
+func (q *Queries) GetTeamSolvedChallengeIDsInEvent(ctx context.Context, arg GetTeamSolvedChallengeIDsInEventParams) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.getTeamSolvedChallengeIDsInEventStmt, getTeamSolvedChallengeIDsInEvent, arg.EventID, arg.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var challenge_id uuid.UUID
+		if err := rows.Scan(&challenge_id); err != nil {
+			return nil, err
+		}
+		items = append(items, challenge_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamsSolvedChallengeInEvent = `-- name: GetTeamsSolvedChallengeInEvent :many
+select id, name, participant_id, timestamp
+from (select distinct on (t.id) t.id, t.name, participant_id, timestamp
+      from event_challenge_solution_attempts
+               inner join event_teams t on t.id = event_challenge_solution_attempts.team_id
+      where t.event_id = $1
+        and challenge_id = $2
+        and is_correct = true
+      order by t.id, timestamp) as first_solutions
+order by timestamp
+`
+
 type GetTeamsSolvedChallengeInEventParams struct {
 	EventID     uuid.UUID `json:"event_id"`
 	ChallengeID uuid.UUID `json:"challenge_id"`
@@ -7,6 +7,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/gofrs/uuid"
 )
@@ -96,7 +97,7 @@ func (q *Queries) DeleteEventChallenges(ctx context.Context, arg DeleteEventChal
 }
 
 const getEventChallengeByID = `-- name: GetEventChallengeByID :one
-select id, event_id, category_id, name, description, points, order_index, exercise_id, exercise_task_id, updated_at, updated_by, created_at
+select id, event_id, category_id, name, description, points, order_index, exercise_id, exercise_task_id, updated_at, updated_by, created_at, release_time, visibility, dynamic_min, dynamic_max, dynamic_solve_threshold
 from event_challenges
 where id = $1 and event_id = $2
 `
@@ -122,12 +123,17 @@ func (q *Queries) GetEventChallengeByID(ctx context.Context, arg GetEventChallen
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.CreatedAt,
+		&i.ReleaseTime,
+		&i.Visibility,
+		&i.DynamicMin,
+		&i.DynamicMax,
+		&i.DynamicSolveThreshold,
 	)
 	return i, err
 }
 
 const getEventChallenges = `-- name: GetEventChallenges :many
-select id, event_id, category_id, name, description, points, order_index, exercise_id, exercise_task_id, updated_at, updated_by, created_at
+select id, event_id, category_id, name, description, points, order_index, exercise_id, exercise_task_id, updated_at, updated_by, created_at, release_time, visibility, dynamic_min, dynamic_max, dynamic_solve_threshold
 from event_challenges
 where event_id = $1
 order by order_index
@@ -155,6 +161,11 @@ func (q *Queries) GetEventChallenges(ctx context.Context, eventID uuid.UUID) ([]
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.CreatedAt,
+			&i.ReleaseTime,
+			&i.Visibility,
+			&i.DynamicMin,
+			&i.DynamicMax,
+			&i.DynamicSolveThreshold,
 		); err != nil {
 			return nil, err
 		}
@@ -193,3 +204,67 @@ func (q *Queries) UpdateEventChallengeOrder(ctx context.Context, arg UpdateEvent
 	)
 	return err
 }
+
+const updateEventChallengeReleaseTime = `-- name: UpdateEventChallengeReleaseTime :exec
+update event_challenges
+set release_time = $3
+where id = $1
+  and event_id = $2
+`
+
+type UpdateEventChallengeReleaseTimeParams struct {
+	ID          uuid.UUID    `json:"id"`
+	EventID     uuid.UUID    `json:"event_id"`
+	ReleaseTime sql.NullTime `json:"release_time"`
+}
+
+func (q *Queries) UpdateEventChallengeReleaseTime(ctx context.Context, arg UpdateEventChallengeReleaseTimeParams) error {
+	_, err := q.exec(ctx, q.updateEventChallengeReleaseTimeStmt, updateEventChallengeReleaseTime, arg.ID, arg.EventID, arg.ReleaseTime)
+	return err
+}
+
+const updateEventChallengeScoring = `-- name: UpdateEventChallengeScoring :exec
+update event_challenges
+set dynamic_min             = $3,
+    dynamic_max             = $4,
+    dynamic_solve_threshold = $5
+where id = $1
+  and event_id = $2
+`
+
+type UpdateEventChallengeScoringParams struct {
+	ID                    uuid.UUID     `json:"id"`
+	EventID               uuid.UUID     `json:"event_id"`
+	DynamicMin            sql.NullInt32 `json:"dynamic_min"`
+	DynamicMax            sql.NullInt32 `json:"dynamic_max"`
+	DynamicSolveThreshold sql.NullInt32 `json:"dynamic_solve_threshold"`
+}
+
+func (q *Queries) UpdateEventChallengeScoring(ctx context.Context, arg UpdateEventChallengeScoringParams) error {
+	_, err := q.exec(ctx, q.updateEventChallengeScoringStmt, updateEventChallengeScoring,
+		arg.ID,
+		arg.EventID,
+		arg.DynamicMin,
+		arg.DynamicMax,
+		arg.DynamicSolveThreshold,
+	)
+	return err
+}
+
+const updateEventChallengeVisibility = `-- name: UpdateEventChallengeVisibility :exec
+update event_challenges
+set visibility = $3
+where id = $1
+  and event_id = $2
+`
+
+type UpdateEventChallengeVisibilityParams struct {
+	ID         uuid.UUID `json:"id"`
+	EventID    uuid.UUID `json:"event_id"`
+	Visibility int32     `json:"visibility"`
+}
+
+func (q *Queries) UpdateEventChallengeVisibility(ctx context.Context, arg UpdateEventChallengeVisibilityParams) error {
+	_, err := q.exec(ctx, q.updateEventChallengeVisibilityStmt, updateEventChallengeVisibility, arg.ID, arg.EventID, arg.Visibility)
+	return err
+}
@@ -7,10 +7,26 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/gofrs/uuid"
 )
 
+const countEventActiveParticipants = `-- name: CountEventActiveParticipants :one
+select count(*)
+from event_participants
+where event_id = $1
+  and approval_status in (1, 2)
+`
+
+func (q *Queries) CountEventActiveParticipants(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	row := q.queryRow(ctx, q.countEventActiveParticipantsStmt, countEventActiveParticipants, eventID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEventParticipant = `-- name: CreateEventParticipant :exec
 insert into event_participants (event_id, user_id, approval_status)
 values ($1, $2, $3)
@@ -27,6 +43,23 @@ func (q *Queries) CreateEventParticipant(ctx context.Context, arg CreateEventPar
 	return err
 }
 
+const deleteEventParticipant = `-- name: DeleteEventParticipant :exec
+delete
+from event_participants
+where event_id = $1
+  and user_id = $2
+`
+
+type DeleteEventParticipantParams struct {
+	EventID uuid.UUID `json:"event_id"`
+	UserID  uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteEventParticipant(ctx context.Context, arg DeleteEventParticipantParams) error {
+	_, err := q.exec(ctx, q.deleteEventParticipantStmt, deleteEventParticipant, arg.EventID, arg.UserID)
+	return err
+}
+
 const getEventJoinStatus = `-- name: GetEventJoinStatus :one
 select approval_status
 from event_participants
@@ -46,27 +79,158 @@ func (q *Queries) GetEventJoinStatus(ctx context.Context, arg GetEventJoinStatus
 	return approval_status, err
 }
 
+const getEventParticipants = `-- name: GetEventParticipants :many
+select event_participants.user_id,
+       users.name,
+       users.email,
+       event_participants.team_id,
+       event_teams.name as team_name,
+       event_participants.approval_status,
+       event_participants.updated_at,
+       event_participants.updated_by,
+       event_participants.created_at
+from event_participants
+         join users on users.id = event_participants.user_id
+         left join event_teams on event_teams.id = event_participants.team_id
+where event_participants.event_id = $1
+order by event_participants.created_at
+`
+
+type GetEventParticipantsRow struct {
+	UserID         uuid.UUID      `json:"user_id"`
+	Name           string         `json:"name"`
+	Email          string         `json:"email"`
+	TeamID         uuid.NullUUID  `json:"team_id"`
+	TeamName       sql.NullString `json:"team_name"`
+	ApprovalStatus int32          `json:"approval_status"`
+	UpdatedAt      sql.NullTime   `json:"updated_at"`
+	UpdatedBy      uuid.NullUUID  `json:"updated_by"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+func (q *Queries) GetEventParticipants(ctx context.Context, eventID uuid.UUID) ([]GetEventParticipantsRow, error) {
+	rows, err := q.query(ctx, q.getEventParticipantsStmt, getEventParticipants, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetEventParticipantsRow{}
+	for rows.Next() {
+		var i GetEventParticipantsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Name,
+			&i.Email,
+			&i.TeamID,
+			&i.TeamName,
+			&i.ApprovalStatus,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventParticipantsUserIDs = `-- name: GetEventParticipantsUserIDs :many
+select user_id
+from event_participants
+where event_id = $1
+`
+
+func (q *Queries) GetEventParticipantsUserIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.getEventParticipantsUserIDsStmt, getEventParticipantsUserIDs, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventWaitlistedParticipants = `-- name: GetEventWaitlistedParticipants :many
+select user_id
+from event_participants
+where event_id = $1
+  and approval_status = 4
+order by created_at
+`
+
+func (q *Queries) GetEventWaitlistedParticipants(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.getEventWaitlistedParticipantsStmt, getEventWaitlistedParticipants, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateEventParticipantStatus = `-- name: UpdateEventParticipantStatus :exec
 update event_participants
-set approval_status = $3
+set approval_status = $3,
+    updated_at      = now(),
+    updated_by      = $4
 where event_id = $1
   and user_id = $2
 `
 
 type UpdateEventParticipantStatusParams struct {
-	EventID        uuid.UUID `json:"event_id"`
-	UserID         uuid.UUID `json:"user_id"`
-	ApprovalStatus int32     `json:"approval_status"`
+	EventID        uuid.UUID     `json:"event_id"`
+	UserID         uuid.UUID     `json:"user_id"`
+	ApprovalStatus int32         `json:"approval_status"`
+	UpdatedBy      uuid.NullUUID `json:"updated_by"`
 }
 
 func (q *Queries) UpdateEventParticipantStatus(ctx context.Context, arg UpdateEventParticipantStatusParams) error {
-	_, err := q.exec(ctx, q.updateEventParticipantStatusStmt, updateEventParticipantStatus, arg.EventID, arg.UserID, arg.ApprovalStatus)
+	_, err := q.exec(ctx, q.updateEventParticipantStatusStmt, updateEventParticipantStatus,
+		arg.EventID,
+		arg.UserID,
+		arg.ApprovalStatus,
+		arg.UpdatedBy,
+	)
 	return err
 }
 
 const updateEventParticipantTeam = `-- name: UpdateEventParticipantTeam :exec
 update event_participants
-set team_id = $3
+set team_id        = $3,
+    team_joined_at = case when $3::uuid is null then null else now() end
 where event_id = $1
   and user_id = $2
 `
@@ -36,6 +36,17 @@ func (q *Queries) CreateEventTeamChallenge(ctx context.Context, arg CreateEventT
 	return err
 }
 
+const deleteEventTeamChallenge = `-- name: DeleteEventTeamChallenge :exec
+delete
+from event_team_challenges
+where id = $1
+`
+
+func (q *Queries) DeleteEventTeamChallenge(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteEventTeamChallengeStmt, deleteEventTeamChallenge, id)
+	return err
+}
+
 const getChallengeFlag = `-- name: GetChallengeFlag :one
 select flag
 from event_team_challenges
@@ -54,3 +65,66 @@ func (q *Queries) GetChallengeFlag(ctx context.Context, arg GetChallengeFlagPara
 	err := row.Scan(&flag)
 	return flag, err
 }
+
+const getChallengeFlagOwnerTeamID = `-- name: GetChallengeFlagOwnerTeamID :one
+select team_id
+from event_team_challenges
+where challenge_id = $1
+  and flag = $2
+  and team_id <> $3
+limit 1
+`
+
+type GetChallengeFlagOwnerTeamIDParams struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Flag        string    `json:"flag"`
+	TeamID      uuid.UUID `json:"team_id"`
+}
+
+func (q *Queries) GetChallengeFlagOwnerTeamID(ctx context.Context, arg GetChallengeFlagOwnerTeamIDParams) (uuid.UUID, error) {
+	row := q.queryRow(ctx, q.getChallengeFlagOwnerTeamIDStmt, getChallengeFlagOwnerTeamID, arg.ChallengeID, arg.Flag, arg.TeamID)
+	var team_id uuid.UUID
+	err := row.Scan(&team_id)
+	return team_id, err
+}
+
+const getEventTeamChallenges = `-- name: GetEventTeamChallenges :many
+select id, challenge_id, flag
+from event_team_challenges
+where event_id = $1
+  and team_id = $2
+`
+
+type GetEventTeamChallengesParams struct {
+	EventID uuid.UUID `json:"event_id"`
+	TeamID  uuid.UUID `json:"team_id"`
+}
+
+type GetEventTeamChallengesRow struct {
+	ID          uuid.UUID `json:"id"`
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Flag        string    `json:"flag"`
+}
+
+func (q *Queries) GetEventTeamChallenges(ctx context.Context, arg GetEventTeamChallengesParams) ([]GetEventTeamChallengesRow, error) {
+	rows, err := q.query(ctx, q.getEventTeamChallengesStmt, getEventTeamChallenges, arg.EventID, arg.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetEventTeamChallengesRow{}
+	for rows.Next() {
+		var i GetEventTeamChallengesRow
+		if err := rows.Scan(&i.ID, &i.ChallengeID, &i.Flag); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -13,6 +13,32 @@ import (
 	"github.com/gofrs/uuid"
 )
 
+const countEventTeamMembers = `-- name: CountEventTeamMembers :one
+select count(*)
+from event_participants
+where team_id = $1
+`
+
+func (q *Queries) CountEventTeamMembers(ctx context.Context, teamID uuid.NullUUID) (int64, error) {
+	row := q.queryRow(ctx, q.countEventTeamMembersStmt, countEventTeamMembers, teamID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countEventTeams = `-- name: CountEventTeams :one
+select count(*)
+from event_teams
+where event_id = $1
+`
+
+func (q *Queries) CountEventTeams(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	row := q.queryRow(ctx, q.countEventTeamsStmt, countEventTeams, eventID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countTeamsInEvents = `-- name: CountTeamsInEvents :many
 select count(*), event_id
 from event_teams
@@ -48,8 +74,8 @@ func (q *Queries) CountTeamsInEvents(ctx context.Context) ([]CountTeamsInEventsR
 }
 
 const createTeamInEvent = `-- name: CreateTeamInEvent :exec
-insert into event_teams (id, name, join_code, event_id, laboratory_id)
-values ($1, $2, $3, $4, $5)
+insert into event_teams (id, name, join_code, event_id, laboratory_id, captain_id)
+values ($1, $2, $3, $4, $5, $6)
 `
 
 type CreateTeamInEventParams struct {
@@ -58,6 +84,7 @@ type CreateTeamInEventParams struct {
 	JoinCode     string        `json:"join_code"`
 	EventID      uuid.UUID     `json:"event_id"`
 	LaboratoryID uuid.NullUUID `json:"laboratory_id"`
+	CaptainID    uuid.NullUUID `json:"captain_id"`
 }
 
 func (q *Queries) CreateTeamInEvent(ctx context.Context, arg CreateTeamInEventParams) error {
@@ -67,12 +94,57 @@ func (q *Queries) CreateTeamInEvent(ctx context.Context, arg CreateTeamInEventPa
 		arg.JoinCode,
 		arg.EventID,
 		arg.LaboratoryID,
+		arg.CaptainID,
 	)
 	return err
 }
 
+const deleteEventTeam = `-- name: DeleteEventTeam :exec
+delete
+from event_teams
+where id = $1
+  and event_id = $2
+`
+
+type DeleteEventTeamParams struct {
+	ID      uuid.UUID `json:"id"`
+	EventID uuid.UUID `json:"event_id"`
+}
+
+func (q *Queries) DeleteEventTeam(ctx context.Context, arg DeleteEventTeamParams) error {
+	_, err := q.exec(ctx, q.deleteEventTeamStmt, deleteEventTeam, arg.ID, arg.EventID)
+	return err
+}
+
+const deleteEventTeamsLaboratories = `-- name: DeleteEventTeamsLaboratories :exec
+update event_teams
+set laboratory_id = null,
+    updated_at    = now()
+where event_id = $1
+`
+
+func (q *Queries) DeleteEventTeamsLaboratories(ctx context.Context, eventID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteEventTeamsLaboratoriesStmt, deleteEventTeamsLaboratories, eventID)
+	return err
+}
+
+const eventTeamActivityExists = `-- name: EventTeamActivityExists :one
+select exists(select true from event_challenge_solution_attempts where event_challenge_solution_attempts.team_id = $1)
+           or exists(select true
+                     from event_cheating_incidents
+                     where event_cheating_incidents.team_id = $1
+                        or event_cheating_incidents.source_team_id = $1) as exists
+`
+
+func (q *Queries) EventTeamActivityExists(ctx context.Context, teamID uuid.UUID) (bool, error) {
+	row := q.queryRow(ctx, q.eventTeamActivityExistsStmt, eventTeamActivityExists, teamID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const getEventParticipantTeam = `-- name: GetEventParticipantTeam :one
-select event_teams.id, name, join_code, laboratory_id
+select event_teams.id, name, join_code, laboratory_id, captain_id, disqualified
 from event_teams
          join event_participants on event_teams.id = event_participants.team_id
 where event_participants.event_id = $1
@@ -89,6 +161,8 @@ type GetEventParticipantTeamRow struct {
 	Name         string        `json:"name"`
 	JoinCode     string        `json:"join_code"`
 	LaboratoryID uuid.NullUUID `json:"laboratory_id"`
+	CaptainID    uuid.NullUUID `json:"captain_id"`
+	Disqualified bool          `json:"disqualified"`
 }
 
 func (q *Queries) GetEventParticipantTeam(ctx context.Context, arg GetEventParticipantTeamParams) (GetEventParticipantTeamRow, error) {
@@ -99,6 +173,8 @@ func (q *Queries) GetEventParticipantTeam(ctx context.Context, arg GetEventParti
 		&i.Name,
 		&i.JoinCode,
 		&i.LaboratoryID,
+		&i.CaptainID,
+		&i.Disqualified,
 	)
 	return i, err
 }
@@ -122,6 +198,47 @@ func (q *Queries) GetEventParticipantTeamID(ctx context.Context, arg GetEventPar
 	return team_id, err
 }
 
+const getEventTeamByID = `-- name: GetEventTeamByID :one
+select id, event_id, name, laboratory_id, captain_id, disqualified, updated_at, updated_by, created_at
+from event_teams
+where id = $1
+  and event_id = $2
+`
+
+type GetEventTeamByIDParams struct {
+	ID      uuid.UUID `json:"id"`
+	EventID uuid.UUID `json:"event_id"`
+}
+
+type GetEventTeamByIDRow struct {
+	ID           uuid.UUID     `json:"id"`
+	EventID      uuid.UUID     `json:"event_id"`
+	Name         string        `json:"name"`
+	LaboratoryID uuid.NullUUID `json:"laboratory_id"`
+	CaptainID    uuid.NullUUID `json:"captain_id"`
+	Disqualified bool          `json:"disqualified"`
+	UpdatedAt    sql.NullTime  `json:"updated_at"`
+	UpdatedBy    uuid.NullUUID `json:"updated_by"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+func (q *Queries) GetEventTeamByID(ctx context.Context, arg GetEventTeamByIDParams) (GetEventTeamByIDRow, error) {
+	row := q.queryRow(ctx, q.getEventTeamByIDStmt, getEventTeamByID, arg.ID, arg.EventID)
+	var i GetEventTeamByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.Name,
+		&i.LaboratoryID,
+		&i.CaptainID,
+		&i.Disqualified,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getEventTeamByName = `-- name: GetEventTeamByName :one
 select id, name, join_code
 from event_teams
@@ -147,8 +264,50 @@ func (q *Queries) GetEventTeamByName(ctx context.Context, arg GetEventTeamByName
 	return i, err
 }
 
+const getEventTeamMembers = `-- name: GetEventTeamMembers :many
+select event_participants.user_id, users.name
+from event_participants
+         join users on users.id = event_participants.user_id
+where event_participants.event_id = $1
+  and event_participants.team_id = $2
+order by event_participants.team_joined_at
+`
+
+type GetEventTeamMembersParams struct {
+	EventID uuid.UUID     `json:"event_id"`
+	TeamID  uuid.NullUUID `json:"team_id"`
+}
+
+type GetEventTeamMembersRow struct {
+	UserID uuid.UUID `json:"user_id"`
+	Name   string    `json:"name"`
+}
+
+func (q *Queries) GetEventTeamMembers(ctx context.Context, arg GetEventTeamMembersParams) ([]GetEventTeamMembersRow, error) {
+	rows, err := q.query(ctx, q.getEventTeamMembersStmt, getEventTeamMembers, arg.EventID, arg.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetEventTeamMembersRow{}
+	for rows.Next() {
+		var i GetEventTeamMembersRow
+		if err := rows.Scan(&i.UserID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEventTeams = `-- name: GetEventTeams :many
-select id, event_id, name, laboratory_id, updated_at, updated_by, created_at
+select id, event_id, name, laboratory_id, captain_id, disqualified, updated_at, updated_by, created_at
 from event_teams
 where event_id = $1
 `
@@ -158,6 +317,8 @@ type GetEventTeamsRow struct {
 	EventID      uuid.UUID     `json:"event_id"`
 	Name         string        `json:"name"`
 	LaboratoryID uuid.NullUUID `json:"laboratory_id"`
+	CaptainID    uuid.NullUUID `json:"captain_id"`
+	Disqualified bool          `json:"disqualified"`
 	UpdatedAt    sql.NullTime  `json:"updated_at"`
 	UpdatedBy    uuid.NullUUID `json:"updated_by"`
 	CreatedAt    time.Time     `json:"created_at"`
@@ -177,6 +338,8 @@ func (q *Queries) GetEventTeams(ctx context.Context, eventID uuid.UUID) ([]GetEv
 			&i.EventID,
 			&i.Name,
 			&i.LaboratoryID,
+			&i.CaptainID,
+			&i.Disqualified,
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.CreatedAt,
@@ -209,3 +372,96 @@ func (q *Queries) TeamExistsInEvent(ctx context.Context, arg TeamExistsInEventPa
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const updateEventTeamCaptain = `-- name: UpdateEventTeamCaptain :exec
+update event_teams
+set captain_id = $2,
+    updated_at = now(),
+    updated_by = $3
+where id = $1
+`
+
+type UpdateEventTeamCaptainParams struct {
+	ID        uuid.UUID     `json:"id"`
+	CaptainID uuid.NullUUID `json:"captain_id"`
+	UpdatedBy uuid.NullUUID `json:"updated_by"`
+}
+
+func (q *Queries) UpdateEventTeamCaptain(ctx context.Context, arg UpdateEventTeamCaptainParams) error {
+	_, err := q.exec(ctx, q.updateEventTeamCaptainStmt, updateEventTeamCaptain, arg.ID, arg.CaptainID, arg.UpdatedBy)
+	return err
+}
+
+const updateEventTeamDisqualified = `-- name: UpdateEventTeamDisqualified :exec
+update event_teams
+set disqualified = $2,
+    updated_at   = now(),
+    updated_by   = $3
+where id = $1
+`
+
+type UpdateEventTeamDisqualifiedParams struct {
+	ID           uuid.UUID     `json:"id"`
+	Disqualified bool          `json:"disqualified"`
+	UpdatedBy    uuid.NullUUID `json:"updated_by"`
+}
+
+func (q *Queries) UpdateEventTeamDisqualified(ctx context.Context, arg UpdateEventTeamDisqualifiedParams) error {
+	_, err := q.exec(ctx, q.updateEventTeamDisqualifiedStmt, updateEventTeamDisqualified, arg.ID, arg.Disqualified, arg.UpdatedBy)
+	return err
+}
+
+const updateEventTeamJoinCode = `-- name: UpdateEventTeamJoinCode :exec
+update event_teams
+set join_code  = $2,
+    updated_at = now(),
+    updated_by = $3
+where id = $1
+`
+
+type UpdateEventTeamJoinCodeParams struct {
+	ID        uuid.UUID     `json:"id"`
+	JoinCode  string        `json:"join_code"`
+	UpdatedBy uuid.NullUUID `json:"updated_by"`
+}
+
+func (q *Queries) UpdateEventTeamJoinCode(ctx context.Context, arg UpdateEventTeamJoinCodeParams) error {
+	_, err := q.exec(ctx, q.updateEventTeamJoinCodeStmt, updateEventTeamJoinCode, arg.ID, arg.JoinCode, arg.UpdatedBy)
+	return err
+}
+
+const updateEventTeamLaboratory = `-- name: UpdateEventTeamLaboratory :exec
+update event_teams
+set laboratory_id = $2,
+    updated_at    = now()
+where id = $1
+`
+
+type UpdateEventTeamLaboratoryParams struct {
+	ID           uuid.UUID     `json:"id"`
+	LaboratoryID uuid.NullUUID `json:"laboratory_id"`
+}
+
+func (q *Queries) UpdateEventTeamLaboratory(ctx context.Context, arg UpdateEventTeamLaboratoryParams) error {
+	_, err := q.exec(ctx, q.updateEventTeamLaboratoryStmt, updateEventTeamLaboratory, arg.ID, arg.LaboratoryID)
+	return err
+}
+
+const updateEventTeamName = `-- name: UpdateEventTeamName :exec
+update event_teams
+set name       = $2,
+    updated_at = now(),
+    updated_by = $3
+where id = $1
+`
+
+type UpdateEventTeamNameParams struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	UpdatedBy uuid.NullUUID `json:"updated_by"`
+}
+
+func (q *Queries) UpdateEventTeamName(ctx context.Context, arg UpdateEventTeamNameParams) error {
+	_, err := q.exec(ctx, q.updateEventTeamNameStmt, updateEventTeamName, arg.ID, arg.Name, arg.UpdatedBy)
+	return err
+}
@@ -7,6 +7,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -15,31 +16,49 @@ import (
 const createEvent = `-- name: CreateEvent :exec
 insert into events (id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring,
                     dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability,
-                    participants_visibility, publish_time, start_time, finish_time, withdraw_time)
-values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+                    participants_visibility, publish_time, start_time, finish_time, withdraw_time,
+                    laboratories_grace_period, max_team_size, max_teams, hide_locked_challenges, solution_attempts_limit,
+                    solution_attempts_window, solution_attempts_cooldown, max_solution_attempts, flag_sharing_action,
+                    scoring_strategy, first_solve_bonus, second_solve_bonus, third_solve_bonus,
+                    scoreboard_freeze_time)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34)
 `
 
 type CreateEventParams struct {
-	ID                     uuid.UUID `json:"id"`
-	Type                   int32     `json:"type"`
-	Availability           int32     `json:"availability"`
-	Participation          int32     `json:"participation"`
-	Tag                    string    `json:"tag"`
-	Name                   string    `json:"name"`
-	Description            string    `json:"description"`
-	Rules                  string    `json:"rules"`
-	Picture                string    `json:"picture"`
-	DynamicScoring         bool      `json:"dynamic_scoring"`
-	DynamicMax             int32     `json:"dynamic_max"`
-	DynamicMin             int32     `json:"dynamic_min"`
-	DynamicSolveThreshold  int32     `json:"dynamic_solve_threshold"`
-	Registration           int32     `json:"registration"`
-	ScoreboardAvailability int32     `json:"scoreboard_availability"`
-	ParticipantsVisibility int32     `json:"participants_visibility"`
-	PublishTime            time.Time `json:"publish_time"`
-	StartTime              time.Time `json:"start_time"`
-	FinishTime             time.Time `json:"finish_time"`
-	WithdrawTime           time.Time `json:"withdraw_time"`
+	ID                       uuid.UUID    `json:"id"`
+	Type                     int32        `json:"type"`
+	Availability             int32        `json:"availability"`
+	Participation            int32        `json:"participation"`
+	Tag                      string       `json:"tag"`
+	Name                     string       `json:"name"`
+	Description              string       `json:"description"`
+	Rules                    string       `json:"rules"`
+	Picture                  string       `json:"picture"`
+	DynamicScoring           bool         `json:"dynamic_scoring"`
+	DynamicMax               int32        `json:"dynamic_max"`
+	DynamicMin               int32        `json:"dynamic_min"`
+	DynamicSolveThreshold    int32        `json:"dynamic_solve_threshold"`
+	Registration             int32        `json:"registration"`
+	ScoreboardAvailability   int32        `json:"scoreboard_availability"`
+	ParticipantsVisibility   int32        `json:"participants_visibility"`
+	PublishTime              time.Time    `json:"publish_time"`
+	StartTime                time.Time    `json:"start_time"`
+	FinishTime               time.Time    `json:"finish_time"`
+	WithdrawTime             time.Time    `json:"withdraw_time"`
+	LaboratoriesGracePeriod  int32        `json:"laboratories_grace_period"`
+	MaxTeamSize              int32        `json:"max_team_size"`
+	MaxTeams                 int32        `json:"max_teams"`
+	HideLockedChallenges     bool         `json:"hide_locked_challenges"`
+	SolutionAttemptsLimit    int32        `json:"solution_attempts_limit"`
+	SolutionAttemptsWindow   int32        `json:"solution_attempts_window"`
+	SolutionAttemptsCooldown int32        `json:"solution_attempts_cooldown"`
+	MaxSolutionAttempts      int32        `json:"max_solution_attempts"`
+	FlagSharingAction        int32        `json:"flag_sharing_action"`
+	ScoringStrategy          int32        `json:"scoring_strategy"`
+	FirstSolveBonus          int32        `json:"first_solve_bonus"`
+	SecondSolveBonus         int32        `json:"second_solve_bonus"`
+	ThirdSolveBonus          int32        `json:"third_solve_bonus"`
+	ScoreboardFreezeTime     sql.NullTime `json:"scoreboard_freeze_time"`
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) error {
@@ -64,6 +83,20 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) error
 		arg.StartTime,
 		arg.FinishTime,
 		arg.WithdrawTime,
+		arg.LaboratoriesGracePeriod,
+		arg.MaxTeamSize,
+		arg.MaxTeams,
+		arg.HideLockedChallenges,
+		arg.SolutionAttemptsLimit,
+		arg.SolutionAttemptsWindow,
+		arg.SolutionAttemptsCooldown,
+		arg.MaxSolutionAttempts,
+		arg.FlagSharingAction,
+		arg.ScoringStrategy,
+		arg.FirstSolveBonus,
+		arg.SecondSolveBonus,
+		arg.ThirdSolveBonus,
+		arg.ScoreboardFreezeTime,
 	)
 	return err
 }
@@ -80,7 +113,7 @@ func (q *Queries) DeleteEvent(ctx context.Context, id uuid.UUID) error {
 }
 
 const getAllEvents = `-- name: GetAllEvents :many
-select id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring, dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability, participants_visibility, publish_time, start_time, finish_time, withdraw_time, updated_at, updated_by, created_at
+select id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring, dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability, participants_visibility, publish_time, start_time, finish_time, withdraw_time, updated_at, updated_by, created_at, laboratories_grace_period, max_team_size, max_teams, hide_locked_challenges, solution_attempts_limit, solution_attempts_window, solution_attempts_cooldown, max_solution_attempts, flag_sharing_action, scoring_strategy, first_solve_bonus, second_solve_bonus, third_solve_bonus, scoreboard_freeze_time, scoreboard_revealed
 from events
 `
 
@@ -117,6 +150,21 @@ func (q *Queries) GetAllEvents(ctx context.Context) ([]Event, error) {
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.CreatedAt,
+			&i.LaboratoriesGracePeriod,
+			&i.MaxTeamSize,
+			&i.MaxTeams,
+			&i.HideLockedChallenges,
+			&i.SolutionAttemptsLimit,
+			&i.SolutionAttemptsWindow,
+			&i.SolutionAttemptsCooldown,
+			&i.MaxSolutionAttempts,
+			&i.FlagSharingAction,
+			&i.ScoringStrategy,
+			&i.FirstSolveBonus,
+			&i.SecondSolveBonus,
+			&i.ThirdSolveBonus,
+			&i.ScoreboardFreezeTime,
+			&i.ScoreboardRevealed,
 		); err != nil {
 			return nil, err
 		}
@@ -132,7 +180,7 @@ func (q *Queries) GetAllEvents(ctx context.Context) ([]Event, error) {
 }
 
 const getEventByID = `-- name: GetEventByID :one
-select id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring, dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability, participants_visibility, publish_time, start_time, finish_time, withdraw_time, updated_at, updated_by, created_at
+select id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring, dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability, participants_visibility, publish_time, start_time, finish_time, withdraw_time, updated_at, updated_by, created_at, laboratories_grace_period, max_team_size, max_teams, hide_locked_challenges, solution_attempts_limit, solution_attempts_window, solution_attempts_cooldown, max_solution_attempts, flag_sharing_action, scoring_strategy, first_solve_bonus, second_solve_bonus, third_solve_bonus, scoreboard_freeze_time, scoreboard_revealed
 from events
 where id = $1
 `
@@ -164,12 +212,27 @@ func (q *Queries) GetEventByID(ctx context.Context, id uuid.UUID) (Event, error)
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.CreatedAt,
+		&i.LaboratoriesGracePeriod,
+		&i.MaxTeamSize,
+		&i.MaxTeams,
+		&i.HideLockedChallenges,
+		&i.SolutionAttemptsLimit,
+		&i.SolutionAttemptsWindow,
+		&i.SolutionAttemptsCooldown,
+		&i.MaxSolutionAttempts,
+		&i.FlagSharingAction,
+		&i.ScoringStrategy,
+		&i.FirstSolveBonus,
+		&i.SecondSolveBonus,
+		&i.ThirdSolveBonus,
+		&i.ScoreboardFreezeTime,
+		&i.ScoreboardRevealed,
 	)
 	return i, err
 }
 
 const getEventByTag = `-- name: GetEventByTag :one
-select id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring, dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability, participants_visibility, publish_time, start_time, finish_time, withdraw_time, updated_at, updated_by, created_at
+select id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring, dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability, participants_visibility, publish_time, start_time, finish_time, withdraw_time, updated_at, updated_by, created_at, laboratories_grace_period, max_team_size, max_teams, hide_locked_challenges, solution_attempts_limit, solution_attempts_window, solution_attempts_cooldown, max_solution_attempts, flag_sharing_action, scoring_strategy, first_solve_bonus, second_solve_bonus, third_solve_bonus, scoreboard_freeze_time, scoreboard_revealed
 from events
 where tag = $1
 `
@@ -201,6 +264,21 @@ func (q *Queries) GetEventByTag(ctx context.Context, tag string) (Event, error)
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.CreatedAt,
+		&i.LaboratoriesGracePeriod,
+		&i.MaxTeamSize,
+		&i.MaxTeams,
+		&i.HideLockedChallenges,
+		&i.SolutionAttemptsLimit,
+		&i.SolutionAttemptsWindow,
+		&i.SolutionAttemptsCooldown,
+		&i.MaxSolutionAttempts,
+		&i.FlagSharingAction,
+		&i.ScoringStrategy,
+		&i.FirstSolveBonus,
+		&i.SecondSolveBonus,
+		&i.ThirdSolveBonus,
+		&i.ScoreboardFreezeTime,
+		&i.ScoreboardRevealed,
 	)
 	return i, err
 }
@@ -235,41 +313,69 @@ func (q *Queries) GetEventIDIfRunning(ctx context.Context, tag string) (uuid.UUI
 
 const updateEvent = `-- name: UpdateEvent :exec
 update events
-set name                    = $2,
-    description             = $3,
-    rules                   = $4,
-    picture                 = $5,
-    dynamic_scoring         = $6,
-    dynamic_max             = $7,
-    dynamic_min             = $8,
-    dynamic_solve_threshold = $9,
-    registration            = $10,
-    scoreboard_availability = $11,
-    participants_visibility = $12,
-    publish_time            = $13,
-    start_time              = $14,
-    finish_time             = $15,
-    withdraw_time           = $16
+set name                       = $2,
+    description                = $3,
+    rules                      = $4,
+    picture                    = $5,
+    dynamic_scoring            = $6,
+    dynamic_max                = $7,
+    dynamic_min                = $8,
+    dynamic_solve_threshold    = $9,
+    registration               = $10,
+    scoreboard_availability    = $11,
+    participants_visibility    = $12,
+    publish_time               = $13,
+    start_time                 = $14,
+    finish_time                = $15,
+    withdraw_time              = $16,
+    laboratories_grace_period  = $17,
+    max_team_size              = $18,
+    max_teams                  = $19,
+    hide_locked_challenges     = $20,
+    solution_attempts_limit    = $21,
+    solution_attempts_window   = $22,
+    solution_attempts_cooldown = $23,
+    max_solution_attempts      = $24,
+    flag_sharing_action        = $25,
+    scoring_strategy           = $26,
+    first_solve_bonus          = $27,
+    second_solve_bonus         = $28,
+    third_solve_bonus          = $29,
+    scoreboard_freeze_time     = $30
 where id = $1
 `
 
 type UpdateEventParams struct {
-	ID                     uuid.UUID `json:"id"`
-	Name                   string    `json:"name"`
-	Description            string    `json:"description"`
-	Rules                  string    `json:"rules"`
-	Picture                string    `json:"picture"`
-	DynamicScoring         bool      `json:"dynamic_scoring"`
-	DynamicMax             int32     `json:"dynamic_max"`
-	DynamicMin             int32     `json:"dynamic_min"`
-	DynamicSolveThreshold  int32     `json:"dynamic_solve_threshold"`
-	Registration           int32     `json:"registration"`
-	ScoreboardAvailability int32     `json:"scoreboard_availability"`
-	ParticipantsVisibility int32     `json:"participants_visibility"`
-	PublishTime            time.Time `json:"publish_time"`
-	StartTime              time.Time `json:"start_time"`
-	FinishTime             time.Time `json:"finish_time"`
-	WithdrawTime           time.Time `json:"withdraw_time"`
+	ID                       uuid.UUID    `json:"id"`
+	Name                     string       `json:"name"`
+	Description              string       `json:"description"`
+	Rules                    string       `json:"rules"`
+	Picture                  string       `json:"picture"`
+	DynamicScoring           bool         `json:"dynamic_scoring"`
+	DynamicMax               int32        `json:"dynamic_max"`
+	DynamicMin               int32        `json:"dynamic_min"`
+	DynamicSolveThreshold    int32        `json:"dynamic_solve_threshold"`
+	Registration             int32        `json:"registration"`
+	ScoreboardAvailability   int32        `json:"scoreboard_availability"`
+	ParticipantsVisibility   int32        `json:"participants_visibility"`
+	PublishTime              time.Time    `json:"publish_time"`
+	StartTime                time.Time    `json:"start_time"`
+	FinishTime               time.Time    `json:"finish_time"`
+	WithdrawTime             time.Time    `json:"withdraw_time"`
+	LaboratoriesGracePeriod  int32        `json:"laboratories_grace_period"`
+	MaxTeamSize              int32        `json:"max_team_size"`
+	MaxTeams                 int32        `json:"max_teams"`
+	HideLockedChallenges     bool         `json:"hide_locked_challenges"`
+	SolutionAttemptsLimit    int32        `json:"solution_attempts_limit"`
+	SolutionAttemptsWindow   int32        `json:"solution_attempts_window"`
+	SolutionAttemptsCooldown int32        `json:"solution_attempts_cooldown"`
+	MaxSolutionAttempts      int32        `json:"max_solution_attempts"`
+	FlagSharingAction        int32        `json:"flag_sharing_action"`
+	ScoringStrategy          int32        `json:"scoring_strategy"`
+	FirstSolveBonus          int32        `json:"first_solve_bonus"`
+	SecondSolveBonus         int32        `json:"second_solve_bonus"`
+	ThirdSolveBonus          int32        `json:"third_solve_bonus"`
+	ScoreboardFreezeTime     sql.NullTime `json:"scoreboard_freeze_time"`
 }
 
 func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) error {
@@ -290,6 +396,36 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) error
 		arg.StartTime,
 		arg.FinishTime,
 		arg.WithdrawTime,
+		arg.LaboratoriesGracePeriod,
+		arg.MaxTeamSize,
+		arg.MaxTeams,
+		arg.HideLockedChallenges,
+		arg.SolutionAttemptsLimit,
+		arg.SolutionAttemptsWindow,
+		arg.SolutionAttemptsCooldown,
+		arg.MaxSolutionAttempts,
+		arg.FlagSharingAction,
+		arg.ScoringStrategy,
+		arg.FirstSolveBonus,
+		arg.SecondSolveBonus,
+		arg.ThirdSolveBonus,
+		arg.ScoreboardFreezeTime,
 	)
 	return err
 }
+
+const updateEventScoreboardRevealed = `-- name: UpdateEventScoreboardRevealed :exec
+update events
+set scoreboard_revealed = $2
+where id = $1
+`
+
+type UpdateEventScoreboardRevealedParams struct {
+	ID                 uuid.UUID `json:"id"`
+	ScoreboardRevealed bool      `json:"scoreboard_revealed"`
+}
+
+func (q *Queries) UpdateEventScoreboardRevealed(ctx context.Context, arg UpdateEventScoreboardRevealedParams) error {
+	_, err := q.exec(ctx, q.updateEventScoreboardRevealedStmt, updateEventScoreboardRevealed, arg.ID, arg.ScoreboardRevealed)
+	return err
+}
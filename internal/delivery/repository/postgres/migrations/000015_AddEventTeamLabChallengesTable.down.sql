@@ -0,0 +1 @@
+drop table if exists event_team_lab_challenges;
\ No newline at end of file
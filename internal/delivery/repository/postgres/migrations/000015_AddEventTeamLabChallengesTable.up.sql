@@ -0,0 +1,17 @@
+create table if not exists event_team_lab_challenges
+(
+    event_id    uuid        not null references events (id) on delete cascade,
+    team_id     uuid        not null references event_teams (id) on delete cascade,
+    exercise_id uuid        not null, -- id of the challenge in the team laboratory
+
+    created_at  timestamptz not null default now(),
+
+    primary key (team_id, exercise_id)
+);
+
+-- every exercise of the already provisioned team challenges was deployed to the team laboratory
+insert into event_team_lab_challenges (event_id, team_id, exercise_id)
+select distinct event_team_challenges.event_id, event_team_challenges.team_id, event_challenges.exercise_id
+from event_team_challenges
+         join event_challenges on event_challenges.id = event_team_challenges.challenge_id
+on conflict do nothing;
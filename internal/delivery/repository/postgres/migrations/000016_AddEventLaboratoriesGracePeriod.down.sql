@@ -0,0 +1,2 @@
+alter table events
+    drop column laboratories_grace_period;
@@ -0,0 +1,2 @@
+alter table events
+    add column laboratories_grace_period integer not null default 0; -- minutes after the event finishes when laboratories are still available
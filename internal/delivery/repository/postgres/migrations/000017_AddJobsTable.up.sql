@@ -0,0 +1,19 @@
+create table if not exists jobs
+(
+    id         uuid primary key,
+
+    job_type   integer      not null, -- 0: reconcile event challenges, 1: release event resources
+    key        varchar(255) not null, -- deduplication key, only one job exists for the key
+    payload    jsonb        not null, -- arguments of the job
+
+    run_at     timestamptz  not null, -- when the job has to be run
+    attempts   integer      not null default 0,
+    last_error text         not null default '',
+    status     integer      not null default 0, -- 0: pending, 1: failed
+
+    updated_at timestamptz,
+
+    created_at timestamptz  not null default now()
+);
+
+create unique index if not exists job_key_index on jobs (key);
@@ -0,0 +1,2 @@
+drop table if exists event_invitations;
+drop table if exists event_invitation_codes;
\ No newline at end of file
@@ -0,0 +1,26 @@
+create table if not exists event_invitation_codes
+(
+    id         uuid primary key,
+    event_id   uuid         not null references events (id) on delete cascade,
+
+    code       varchar(255) not null,
+    max_uses   integer      not null default 0, -- 0: unlimited
+    uses       integer      not null default 0,
+    expires_at timestamptz, -- null: never expires
+
+    created_by uuid         references users (id) on delete set null,
+    created_at timestamptz  not null default now()
+);
+
+create unique index if not exists event_invitation_code_index on event_invitation_codes (code); -- for checking the code is unique
+
+create table if not exists event_invitations
+(
+    event_id   uuid        not null references events (id) on delete cascade,
+    user_id    uuid        not null references users (id) on delete cascade,
+
+    created_by uuid        references users (id) on delete set null,
+    created_at timestamptz not null default now(),
+
+    primary key (event_id, user_id)
+);
@@ -0,0 +1,2 @@
+alter table event_teams
+    drop column captain_id;
@@ -0,0 +1,10 @@
+alter table event_teams
+    add column captain_id uuid references users (id) on delete set null;
+
+-- the first member of the existing team is its creator
+update event_teams
+set captain_id = (select user_id
+                  from event_participants
+                  where event_participants.team_id = event_teams.id
+                  order by event_participants.created_at
+                  limit 1);
@@ -0,0 +1,7 @@
+delete
+from platform_settings
+where key = 'waitlist_promotion_template';
+
+alter table events
+    drop column max_team_size,
+    drop column max_teams;
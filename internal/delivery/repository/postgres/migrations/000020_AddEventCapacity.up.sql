@@ -0,0 +1,9 @@
+alter table events
+    add column max_team_size integer not null default 0, -- 0: unlimited
+    add column max_teams     integer not null default 0; -- 0: unlimited
+
+insert into platform_settings (type, key, value)
+values ('email_template_subject', 'waitlist_promotion_template', 'You are registered for {{.EventName}}'),
+       ('email_template_body', 'waitlist_promotion_template',
+        '<p>Hello, {{.Username}}!</p><p>A place has become available in <a href="{{.Link}}">{{.EventName}}</a> and your registration has moved off the waitlist.</p>')
+on conflict do nothing;
@@ -0,0 +1,2 @@
+drop table if exists event_team_hint_unlocks;
+drop table if exists event_challenge_hints;
\ No newline at end of file
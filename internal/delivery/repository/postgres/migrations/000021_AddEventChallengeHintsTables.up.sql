@@ -0,0 +1,29 @@
+create table if not exists event_challenge_hints
+(
+    id           uuid primary key,
+    event_id     uuid        not null references events (id) on delete cascade,
+    challenge_id uuid        not null references event_challenges (id) on delete cascade,
+
+    order_index  integer     not null,
+    content      text        not null,
+    cost         integer     not null default 0, -- 0: free hint
+
+    created_at   timestamptz not null default now()
+);
+
+create unique index if not exists event_challenge_hint_index on event_challenge_hints (challenge_id, order_index); -- for checking the hint order is unique in the challenge
+
+create table if not exists event_team_hint_unlocks
+(
+    event_id     uuid        not null references events (id) on delete cascade,
+    team_id      uuid        not null references event_teams (id) on delete cascade,
+    challenge_id uuid        not null references event_challenges (id) on delete cascade,
+    hint_id      uuid        not null references event_challenge_hints (id) on delete cascade,
+
+    cost         integer     not null, -- cost of the hint at the moment of unlocking
+
+    unlocked_by  uuid        references users (id) on delete set null,
+    created_at   timestamptz not null default now(),
+
+    primary key (team_id, hint_id)
+);
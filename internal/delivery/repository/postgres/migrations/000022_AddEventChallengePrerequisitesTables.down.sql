@@ -0,0 +1,5 @@
+drop table if exists event_challenge_prerequisite_challenges;
+drop table if exists event_challenge_prerequisites;
+
+alter table events
+    drop column hide_locked_challenges;
@@ -0,0 +1,23 @@
+alter table events
+    add column hide_locked_challenges boolean not null default false;
+
+create table if not exists event_challenge_prerequisites
+(
+    id             uuid primary key,
+    event_id       uuid        not null references events (id) on delete cascade,
+    challenge_id   uuid        not null references event_challenges (id) on delete cascade,
+
+    type           integer     not null, -- 0: challenge solved, 1: number of challenges solved, 2: score reached
+    required_count integer     not null default 0,
+    required_score integer     not null default 0,
+
+    created_at     timestamptz not null default now()
+);
+
+create table if not exists event_challenge_prerequisite_challenges
+(
+    prerequisite_id uuid not null references event_challenge_prerequisites (id) on delete cascade,
+    challenge_id    uuid not null references event_challenges (id) on delete cascade,
+
+    primary key (prerequisite_id, challenge_id)
+);
@@ -0,0 +1,5 @@
+alter table event_challenges
+    drop column release_time;
+
+alter table event_challenge_categories
+    drop column release_time;
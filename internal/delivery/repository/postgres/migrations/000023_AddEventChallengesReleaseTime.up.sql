@@ -0,0 +1,5 @@
+alter table event_challenge_categories
+    add column release_time timestamptz; -- null: released with the event start
+
+alter table event_challenges
+    add column release_time timestamptz; -- null: released with the category
@@ -0,0 +1,5 @@
+alter table event_challenges
+    drop column visibility;
+
+alter table event_challenge_categories
+    drop column visibility;
@@ -0,0 +1,5 @@
+alter table event_challenge_categories
+    add column visibility integer not null default 1; -- 0: draft, 1: visible, 2: archived
+
+alter table event_challenges
+    add column visibility integer not null default 1; -- 0: draft, 1: visible, 2: archived
@@ -0,0 +1,5 @@
+alter table events
+    drop column solution_attempts_limit,
+    drop column solution_attempts_window,
+    drop column solution_attempts_cooldown,
+    drop column max_solution_attempts;
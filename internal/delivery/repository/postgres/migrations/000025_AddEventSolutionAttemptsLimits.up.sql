@@ -0,0 +1,5 @@
+alter table events
+    add column solution_attempts_limit    integer not null default 0, -- 0: unlimited attempts per window
+    add column solution_attempts_window   integer not null default 60, -- in seconds
+    add column solution_attempts_cooldown integer not null default 0, -- in seconds
+    add column max_solution_attempts      integer not null default 0; -- 0: unlimited attempts per challenge
@@ -0,0 +1,7 @@
+drop table if exists event_cheating_incidents;
+
+alter table event_teams
+    drop column disqualified;
+
+alter table events
+    drop column flag_sharing_action;
@@ -0,0 +1,19 @@
+alter table events
+    add column flag_sharing_action integer not null default 0; -- 0: log only, 1: reject, 2: disqualify
+
+alter table event_teams
+    add column disqualified boolean not null default false;
+
+create table if not exists event_cheating_incidents
+(
+    id             uuid primary key,
+    event_id       uuid        not null references events (id) on delete cascade,
+    challenge_id   uuid        not null references event_challenges (id) on delete cascade,
+
+    team_id        uuid        not null references event_teams (id) on delete cascade, -- team submitted the shared flag
+    source_team_id uuid        not null references event_teams (id) on delete cascade, -- team the flag belongs to
+    participant_id uuid        references users (id) on delete set null,
+    answer         text        not null,
+
+    created_at     timestamptz not null default now()
+);
@@ -0,0 +1,7 @@
+alter table event_challenges
+    drop column dynamic_min,
+    drop column dynamic_max,
+    drop column dynamic_solve_threshold;
+
+alter table events
+    drop column scoring_strategy;
@@ -0,0 +1,7 @@
+alter table events
+    add column scoring_strategy integer not null default 0; -- 0: quadratic, 1: logarithmic, 2: linear
+
+alter table event_challenges
+    add column dynamic_min integer,
+    add column dynamic_max integer,
+    add column dynamic_solve_threshold integer;
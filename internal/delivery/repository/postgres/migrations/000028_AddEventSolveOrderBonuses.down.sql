@@ -0,0 +1,4 @@
+alter table events
+    drop column first_solve_bonus,
+    drop column second_solve_bonus,
+    drop column third_solve_bonus;
@@ -0,0 +1,4 @@
+alter table events
+    add column first_solve_bonus  integer not null default 0,
+    add column second_solve_bonus integer not null default 0,
+    add column third_solve_bonus  integer not null default 0;
@@ -0,0 +1 @@
+drop table if exists event_score_adjustments;
@@ -0,0 +1,15 @@
+create table if not exists event_score_adjustments
+(
+    id         uuid primary key,
+    event_id   uuid        not null references events (id) on delete cascade,
+    team_id    uuid        not null references event_teams (id) on delete cascade,
+
+    points     integer     not null, -- positive: award, negative: penalty
+    reason     text        not null,
+
+    updated_at timestamptz,
+    updated_by uuid        references users (id) on delete set null,
+
+    created_by uuid        references users (id) on delete set null,
+    created_at timestamptz not null default now()
+);
@@ -0,0 +1,3 @@
+alter table events
+    drop column scoreboard_freeze_time,
+    drop column scoreboard_revealed;
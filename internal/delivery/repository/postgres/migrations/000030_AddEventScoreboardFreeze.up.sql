@@ -0,0 +1,3 @@
+alter table events
+    add column scoreboard_freeze_time timestamptz,                   -- null: scoreboard is not frozen
+    add column scoreboard_revealed    boolean not null default false; -- frozen scoreboard is revealed by the organizers
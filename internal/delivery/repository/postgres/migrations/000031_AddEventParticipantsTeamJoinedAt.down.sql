@@ -0,0 +1,2 @@
+alter table event_participants
+    drop column team_joined_at;
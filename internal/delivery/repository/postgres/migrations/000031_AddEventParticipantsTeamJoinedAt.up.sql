@@ -0,0 +1,7 @@
+alter table event_participants
+    add column team_joined_at timestamptz; -- null: participant is not in the team
+
+-- the existing members are ordered by the time of joining the event
+update event_participants
+set team_joined_at = created_at
+where team_id is not null;
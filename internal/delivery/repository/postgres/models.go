@@ -13,54 +13,152 @@ import (
 )
 
 type Event struct {
-	ID                     uuid.UUID     `json:"id"`
-	Type                   int32         `json:"type"`
-	Availability           int32         `json:"availability"`
-	Participation          int32         `json:"participation"`
-	Tag                    string        `json:"tag"`
-	Name                   string        `json:"name"`
-	Description            string        `json:"description"`
-	Rules                  string        `json:"rules"`
-	Picture                string        `json:"picture"`
-	DynamicScoring         bool          `json:"dynamic_scoring"`
-	DynamicMax             int32         `json:"dynamic_max"`
-	DynamicMin             int32         `json:"dynamic_min"`
-	DynamicSolveThreshold  int32         `json:"dynamic_solve_threshold"`
-	Registration           int32         `json:"registration"`
-	ScoreboardAvailability int32         `json:"scoreboard_availability"`
-	ParticipantsVisibility int32         `json:"participants_visibility"`
-	PublishTime            time.Time     `json:"publish_time"`
-	StartTime              time.Time     `json:"start_time"`
-	FinishTime             time.Time     `json:"finish_time"`
-	WithdrawTime           time.Time     `json:"withdraw_time"`
-	UpdatedAt              sql.NullTime  `json:"updated_at"`
-	UpdatedBy              uuid.NullUUID `json:"updated_by"`
-	CreatedAt              time.Time     `json:"created_at"`
+	ID                       uuid.UUID     `json:"id"`
+	Type                     int32         `json:"type"`
+	Availability             int32         `json:"availability"`
+	Participation            int32         `json:"participation"`
+	Tag                      string        `json:"tag"`
+	Name                     string        `json:"name"`
+	Description              string        `json:"description"`
+	Rules                    string        `json:"rules"`
+	Picture                  string        `json:"picture"`
+	DynamicScoring           bool          `json:"dynamic_scoring"`
+	DynamicMax               int32         `json:"dynamic_max"`
+	DynamicMin               int32         `json:"dynamic_min"`
+	DynamicSolveThreshold    int32         `json:"dynamic_solve_threshold"`
+	Registration             int32         `json:"registration"`
+	ScoreboardAvailability   int32         `json:"scoreboard_availability"`
+	ParticipantsVisibility   int32         `json:"participants_visibility"`
+	PublishTime              time.Time     `json:"publish_time"`
+	StartTime                time.Time     `json:"start_time"`
+	FinishTime               time.Time     `json:"finish_time"`
+	WithdrawTime             time.Time     `json:"withdraw_time"`
+	UpdatedAt                sql.NullTime  `json:"updated_at"`
+	UpdatedBy                uuid.NullUUID `json:"updated_by"`
+	CreatedAt                time.Time     `json:"created_at"`
+	LaboratoriesGracePeriod  int32         `json:"laboratories_grace_period"`
+	MaxTeamSize              int32         `json:"max_team_size"`
+	MaxTeams                 int32         `json:"max_teams"`
+	HideLockedChallenges     bool          `json:"hide_locked_challenges"`
+	SolutionAttemptsLimit    int32         `json:"solution_attempts_limit"`
+	SolutionAttemptsWindow   int32         `json:"solution_attempts_window"`
+	SolutionAttemptsCooldown int32         `json:"solution_attempts_cooldown"`
+	MaxSolutionAttempts      int32         `json:"max_solution_attempts"`
+	FlagSharingAction        int32         `json:"flag_sharing_action"`
+	ScoringStrategy          int32         `json:"scoring_strategy"`
+	FirstSolveBonus          int32         `json:"first_solve_bonus"`
+	SecondSolveBonus         int32         `json:"second_solve_bonus"`
+	ThirdSolveBonus          int32         `json:"third_solve_bonus"`
+	ScoreboardFreezeTime     sql.NullTime  `json:"scoreboard_freeze_time"`
+	ScoreboardRevealed       bool          `json:"scoreboard_revealed"`
 }
 
 type EventChallenge struct {
-	ID             uuid.UUID     `json:"id"`
-	EventID        uuid.UUID     `json:"event_id"`
-	CategoryID     uuid.UUID     `json:"category_id"`
-	Name           string        `json:"name"`
-	Description    string        `json:"description"`
-	Points         int32         `json:"points"`
-	OrderIndex     int32         `json:"order_index"`
-	ExerciseID     uuid.UUID     `json:"exercise_id"`
-	ExerciseTaskID uuid.UUID     `json:"exercise_task_id"`
-	UpdatedAt      sql.NullTime  `json:"updated_at"`
-	UpdatedBy      uuid.NullUUID `json:"updated_by"`
-	CreatedAt      time.Time     `json:"created_at"`
+	ID                    uuid.UUID     `json:"id"`
+	EventID               uuid.UUID     `json:"event_id"`
+	CategoryID            uuid.UUID     `json:"category_id"`
+	Name                  string        `json:"name"`
+	Description           string        `json:"description"`
+	Points                int32         `json:"points"`
+	OrderIndex            int32         `json:"order_index"`
+	ExerciseID            uuid.UUID     `json:"exercise_id"`
+	ExerciseTaskID        uuid.UUID     `json:"exercise_task_id"`
+	UpdatedAt             sql.NullTime  `json:"updated_at"`
+	UpdatedBy             uuid.NullUUID `json:"updated_by"`
+	CreatedAt             time.Time     `json:"created_at"`
+	ReleaseTime           sql.NullTime  `json:"release_time"`
+	Visibility            int32         `json:"visibility"`
+	DynamicMin            sql.NullInt32 `json:"dynamic_min"`
+	DynamicMax            sql.NullInt32 `json:"dynamic_max"`
+	DynamicSolveThreshold sql.NullInt32 `json:"dynamic_solve_threshold"`
 }
 
 type EventChallengeCategory struct {
-	ID         uuid.UUID     `json:"id"`
-	EventID    uuid.UUID     `json:"event_id"`
-	Name       string        `json:"name"`
-	OrderIndex int32         `json:"order_index"`
-	UpdatedAt  sql.NullTime  `json:"updated_at"`
-	UpdatedBy  uuid.NullUUID `json:"updated_by"`
-	CreatedAt  time.Time     `json:"created_at"`
+	ID          uuid.UUID     `json:"id"`
+	EventID     uuid.UUID     `json:"event_id"`
+	Name        string        `json:"name"`
+	OrderIndex  int32         `json:"order_index"`
+	UpdatedAt   sql.NullTime  `json:"updated_at"`
+	UpdatedBy   uuid.NullUUID `json:"updated_by"`
+	CreatedAt   time.Time     `json:"created_at"`
+	ReleaseTime sql.NullTime  `json:"release_time"`
+	Visibility  int32         `json:"visibility"`
+}
+
+type EventChallengeHint struct {
+	ID          uuid.UUID `json:"id"`
+	EventID     uuid.UUID `json:"event_id"`
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	OrderIndex  int32     `json:"order_index"`
+	Content     string    `json:"content"`
+	Cost        int32     `json:"cost"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type EventChallengePrerequisite struct {
+	ID            uuid.UUID `json:"id"`
+	EventID       uuid.UUID `json:"event_id"`
+	ChallengeID   uuid.UUID `json:"challenge_id"`
+	Type          int32     `json:"type"`
+	RequiredCount int32     `json:"required_count"`
+	RequiredScore int32     `json:"required_score"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type EventChallengePrerequisiteChallenge struct {
+	PrerequisiteID uuid.UUID `json:"prerequisite_id"`
+	ChallengeID    uuid.UUID `json:"challenge_id"`
+}
+
+type EventCheatingIncident struct {
+	ID            uuid.UUID     `json:"id"`
+	EventID       uuid.UUID     `json:"event_id"`
+	ChallengeID   uuid.UUID     `json:"challenge_id"`
+	TeamID        uuid.UUID     `json:"team_id"`
+	SourceTeamID  uuid.UUID     `json:"source_team_id"`
+	ParticipantID uuid.NullUUID `json:"participant_id"`
+	Answer        string        `json:"answer"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type EventInvitation struct {
+	EventID   uuid.UUID     `json:"event_id"`
+	UserID    uuid.UUID     `json:"user_id"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type EventInvitationCode struct {
+	ID        uuid.UUID     `json:"id"`
+	EventID   uuid.UUID     `json:"event_id"`
+	Code      string        `json:"code"`
+	MaxUses   int32         `json:"max_uses"`
+	Uses      int32         `json:"uses"`
+	ExpiresAt sql.NullTime  `json:"expires_at"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type EventScoreAdjustment struct {
+	ID        uuid.UUID     `json:"id"`
+	EventID   uuid.UUID     `json:"event_id"`
+	TeamID    uuid.UUID     `json:"team_id"`
+	Points    int32         `json:"points"`
+	Reason    string        `json:"reason"`
+	UpdatedAt sql.NullTime  `json:"updated_at"`
+	UpdatedBy uuid.NullUUID `json:"updated_by"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type EventTeamHintUnlock struct {
+	EventID     uuid.UUID     `json:"event_id"`
+	TeamID      uuid.UUID     `json:"team_id"`
+	ChallengeID uuid.UUID     `json:"challenge_id"`
+	HintID      uuid.UUID     `json:"hint_id"`
+	Cost        int32         `json:"cost"`
+	UnlockedBy  uuid.NullUUID `json:"unlocked_by"`
+	CreatedAt   time.Time     `json:"created_at"`
 }
 
 type Exercise struct {
@@ -89,6 +187,19 @@ type File struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type Job struct {
+	ID        uuid.UUID       `json:"id"`
+	JobType   int32           `json:"job_type"`
+	Key       string          `json:"key"`
+	Payload   json.RawMessage `json:"payload"`
+	RunAt     time.Time       `json:"run_at"`
+	Attempts  int32           `json:"attempts"`
+	LastError string          `json:"last_error"`
+	Status    int32           `json:"status"`
+	UpdatedAt sql.NullTime    `json:"updated_at"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
 type TemporalCode struct {
 	ID        uuid.UUID `json:"id"`
 	ExpiredAt time.Time `json:"expired_at"`
@@ -6,34 +6,66 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/gofrs/uuid"
 )
 
 type Querier interface {
 	CountChallengesInEvents(ctx context.Context) ([]CountChallengesInEventsRow, error)
+	CountEventActiveParticipants(ctx context.Context, eventID uuid.UUID) (int64, error)
+	CountEventTeamMembers(ctx context.Context, teamID uuid.NullUUID) (int64, error)
+	CountEventTeams(ctx context.Context, eventID uuid.UUID) (int64, error)
 	CountTeamsInEvents(ctx context.Context) ([]CountTeamsInEventsRow, error)
 	CreateEvent(ctx context.Context, arg CreateEventParams) error
 	CreateEventChallenge(ctx context.Context, arg CreateEventChallengeParams) error
 	CreateEventChallengeCategory(ctx context.Context, arg CreateEventChallengeCategoryParams) error
+	CreateEventChallengeHint(ctx context.Context, arg CreateEventChallengeHintParams) error
+	CreateEventChallengePrerequisite(ctx context.Context, arg CreateEventChallengePrerequisiteParams) error
+	CreateEventChallengePrerequisiteChallenge(ctx context.Context, arg CreateEventChallengePrerequisiteChallengeParams) error
 	CreateEventChallengeSolutionAttempt(ctx context.Context, arg CreateEventChallengeSolutionAttemptParams) error
+	CreateEventCheatingIncident(ctx context.Context, arg CreateEventCheatingIncidentParams) error
+	CreateEventInvitation(ctx context.Context, arg CreateEventInvitationParams) error
+	CreateEventInvitationCode(ctx context.Context, arg CreateEventInvitationCodeParams) error
 	CreateEventParticipant(ctx context.Context, arg CreateEventParticipantParams) error
+	CreateEventScoreAdjustment(ctx context.Context, arg CreateEventScoreAdjustmentParams) error
 	CreateEventTeamChallenge(ctx context.Context, arg CreateEventTeamChallengeParams) error
+	CreateEventTeamHintUnlock(ctx context.Context, arg CreateEventTeamHintUnlockParams) error
+	CreateEventTeamLabChallenge(ctx context.Context, arg CreateEventTeamLabChallengeParams) error
 	CreateExercise(ctx context.Context, arg CreateExerciseParams) error
 	CreateExerciseCategory(ctx context.Context, arg CreateExerciseCategoryParams) error
 	CreateFile(ctx context.Context, arg CreateFileParams) error
+	CreateJob(ctx context.Context, arg CreateJobParams) error
 	CreateTeamInEvent(ctx context.Context, arg CreateTeamInEventParams) error
 	CreateTemporalCode(ctx context.Context, arg CreateTemporalCodeParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
 	DeleteEvent(ctx context.Context, id uuid.UUID) error
 	DeleteEventChallengeCategory(ctx context.Context, arg DeleteEventChallengeCategoryParams) error
+	DeleteEventChallengePrerequisites(ctx context.Context, arg DeleteEventChallengePrerequisitesParams) error
 	DeleteEventChallenges(ctx context.Context, arg DeleteEventChallengesParams) error
+	DeleteEventInvitation(ctx context.Context, arg DeleteEventInvitationParams) error
+	DeleteEventInvitationCode(ctx context.Context, arg DeleteEventInvitationCodeParams) error
+	DeleteEventLabChallenges(ctx context.Context, arg DeleteEventLabChallengesParams) error
+	DeleteEventParticipant(ctx context.Context, arg DeleteEventParticipantParams) error
+	DeleteEventScoreAdjustment(ctx context.Context, arg DeleteEventScoreAdjustmentParams) error
+	DeleteEventTeam(ctx context.Context, arg DeleteEventTeamParams) error
+	DeleteEventTeamChallenge(ctx context.Context, id uuid.UUID) error
+	DeleteEventTeamLabChallenge(ctx context.Context, arg DeleteEventTeamLabChallengeParams) error
+	DeleteEventTeamLabChallenges(ctx context.Context, teamID uuid.UUID) error
+	DeleteEventTeamsLabChallenges(ctx context.Context, eventID uuid.UUID) error
+	DeleteEventTeamsLaboratories(ctx context.Context, eventID uuid.UUID) error
 	DeleteExercise(ctx context.Context, id uuid.UUID) error
 	DeleteExerciseCategory(ctx context.Context, id uuid.UUID) error
 	DeleteFile(ctx context.Context, id uuid.UUID) error
+	DeleteJob(ctx context.Context, arg DeleteJobParams) error
+	DeleteJobByKey(ctx context.Context, key string) error
 	DeleteTemporalCode(ctx context.Context, id uuid.UUID) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
 	DoesUserExistByID(ctx context.Context, id uuid.UUID) (bool, error)
+	EventInvitationCodeValid(ctx context.Context, arg EventInvitationCodeValidParams) (bool, error)
+	EventInvitationExists(ctx context.Context, arg EventInvitationExistsParams) (bool, error)
+	EventTeamActivityExists(ctx context.Context, teamID uuid.UUID) (bool, error)
+	EventTeamCheatingIncidentExists(ctx context.Context, arg EventTeamCheatingIncidentExistsParams) (bool, error)
 	// -- name: GetAllSolvedChallengesIDsByTeamInEvent :many
 	// select challenge_id
 	// from event_challenge_solution_attempts
@@ -44,6 +76,7 @@ type Querier interface {
 	GetAllEvents(ctx context.Context) ([]Event, error)
 	GetAllUsers(ctx context.Context) ([]GetAllUsersRow, error)
 	GetChallengeFlag(ctx context.Context, arg GetChallengeFlagParams) (string, error)
+	GetChallengeFlagOwnerTeamID(ctx context.Context, arg GetChallengeFlagOwnerTeamIDParams) (uuid.UUID, error)
 	GetEmailTemplateBody(ctx context.Context, key string) (string, error)
 	GetEmailTemplateSubject(ctx context.Context, key string) (string, error)
 	GetEventByID(ctx context.Context, id uuid.UUID) (Event, error)
@@ -51,39 +84,74 @@ type Querier interface {
 	GetEventChallengeByID(ctx context.Context, arg GetEventChallengeByIDParams) (EventChallenge, error)
 	GetEventChallengeCategories(ctx context.Context, eventID uuid.UUID) ([]EventChallengeCategory, error)
 	GetEventChallenges(ctx context.Context, eventID uuid.UUID) ([]EventChallenge, error)
+	GetEventChallengesHints(ctx context.Context, eventID uuid.UUID) ([]EventChallengeHint, error)
+	GetEventChallengesPrerequisiteChallenges(ctx context.Context, eventID uuid.UUID) ([]EventChallengePrerequisiteChallenge, error)
+	GetEventChallengesPrerequisites(ctx context.Context, eventID uuid.UUID) ([]EventChallengePrerequisite, error)
+	GetEventCheatingIncidents(ctx context.Context, eventID uuid.UUID) ([]GetEventCheatingIncidentsRow, error)
+	GetEventHintUnlocks(ctx context.Context, eventID uuid.UUID) ([]GetEventHintUnlocksRow, error)
 	GetEventIDIfNotWithdrawn(ctx context.Context, tag string) (uuid.UUID, error)
 	GetEventIDIfRunning(ctx context.Context, tag string) (uuid.UUID, error)
+	GetEventInvitationCodes(ctx context.Context, eventID uuid.UUID) ([]EventInvitationCode, error)
+	GetEventInvitations(ctx context.Context, eventID uuid.UUID) ([]GetEventInvitationsRow, error)
 	GetEventJoinStatus(ctx context.Context, arg GetEventJoinStatusParams) (int32, error)
 	GetEventParticipantTeam(ctx context.Context, arg GetEventParticipantTeamParams) (GetEventParticipantTeamRow, error)
 	GetEventParticipantTeamID(ctx context.Context, arg GetEventParticipantTeamIDParams) (uuid.NullUUID, error)
+	GetEventParticipants(ctx context.Context, eventID uuid.UUID) ([]GetEventParticipantsRow, error)
+	GetEventParticipantsUserIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error)
+	GetEventScoreAdjustments(ctx context.Context, eventID uuid.UUID) ([]EventScoreAdjustment, error)
+	GetEventTeamByID(ctx context.Context, arg GetEventTeamByIDParams) (GetEventTeamByIDRow, error)
 	GetEventTeamByName(ctx context.Context, arg GetEventTeamByNameParams) (GetEventTeamByNameRow, error)
+	GetEventTeamChallenges(ctx context.Context, arg GetEventTeamChallengesParams) ([]GetEventTeamChallengesRow, error)
+	GetEventTeamLabChallenges(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error)
+	GetEventTeamMembers(ctx context.Context, arg GetEventTeamMembersParams) ([]GetEventTeamMembersRow, error)
+	GetEventTeamUnlockedHintIDs(ctx context.Context, arg GetEventTeamUnlockedHintIDsParams) ([]uuid.UUID, error)
 	GetEventTeams(ctx context.Context, eventID uuid.UUID) ([]GetEventTeamsRow, error)
+	GetEventWaitlistedParticipants(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error)
 	GetExerciseByID(ctx context.Context, id uuid.UUID) (Exercise, error)
 	GetExerciseCategories(ctx context.Context) ([]ExerciseCategory, error)
 	GetExercises(ctx context.Context) ([]Exercise, error)
 	GetExercisesByCategory(ctx context.Context, categoryID uuid.UUID) ([]Exercise, error)
 	GetFileByID(ctx context.Context, id uuid.UUID) (File, error)
+	GetJobByKey(ctx context.Context, key string) (Job, error)
+	GetJobsByStatus(ctx context.Context, status int32) ([]Job, error)
+	GetTeamChallengeSolutionAttemptTimes(ctx context.Context, arg GetTeamChallengeSolutionAttemptTimesParams) ([]time.Time, error)
+	GetTeamSolvedChallengeIDsInEvent(ctx context.Context, arg GetTeamSolvedChallengeIDsInEventParams) ([]uuid.UUID, error)
 	GetTeamsSolvedChallengeInEvent(ctx context.Context, arg GetTeamsSolvedChallengeInEventParams) ([]GetTeamsSolvedChallengeInEventRow, error)
 	GetTemporalCode(ctx context.Context, id uuid.UUID) (TemporalCode, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserInvitedEventIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 	GetUsersWithSimilar(ctx context.Context, search string) ([]GetUsersWithSimilarRow, error)
+	ReleaseEventInvitationCodeUse(ctx context.Context, id uuid.UUID) error
 	SetLastSeen(ctx context.Context, id uuid.UUID) error
 	TeamExistsInEvent(ctx context.Context, arg TeamExistsInEventParams) (bool, error)
 	UpdateEvent(ctx context.Context, arg UpdateEventParams) error
 	UpdateEventChallengeCategory(ctx context.Context, arg UpdateEventChallengeCategoryParams) error
 	UpdateEventChallengeCategoryOrder(ctx context.Context, arg UpdateEventChallengeCategoryOrderParams) error
+	UpdateEventChallengeCategoryVisibility(ctx context.Context, arg UpdateEventChallengeCategoryVisibilityParams) error
 	UpdateEventChallengeOrder(ctx context.Context, arg UpdateEventChallengeOrderParams) error
+	UpdateEventChallengeReleaseTime(ctx context.Context, arg UpdateEventChallengeReleaseTimeParams) error
+	UpdateEventChallengeScoring(ctx context.Context, arg UpdateEventChallengeScoringParams) error
+	UpdateEventChallengeVisibility(ctx context.Context, arg UpdateEventChallengeVisibilityParams) error
 	UpdateEventParticipantStatus(ctx context.Context, arg UpdateEventParticipantStatusParams) error
 	UpdateEventParticipantTeam(ctx context.Context, arg UpdateEventParticipantTeamParams) error
+	UpdateEventScoreAdjustment(ctx context.Context, arg UpdateEventScoreAdjustmentParams) error
+	UpdateEventScoreboardRevealed(ctx context.Context, arg UpdateEventScoreboardRevealedParams) error
+	UpdateEventTeamCaptain(ctx context.Context, arg UpdateEventTeamCaptainParams) error
+	UpdateEventTeamDisqualified(ctx context.Context, arg UpdateEventTeamDisqualifiedParams) error
+	UpdateEventTeamJoinCode(ctx context.Context, arg UpdateEventTeamJoinCodeParams) error
+	UpdateEventTeamLaboratory(ctx context.Context, arg UpdateEventTeamLaboratoryParams) error
+	UpdateEventTeamName(ctx context.Context, arg UpdateEventTeamNameParams) error
 	UpdateExercise(ctx context.Context, arg UpdateExerciseParams) error
 	UpdateExerciseCategory(ctx context.Context, arg UpdateExerciseCategoryParams) error
+	UpdateJobAttempt(ctx context.Context, arg UpdateJobAttemptParams) error
 	UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error
 	UpdateUserGoogleID(ctx context.Context, arg UpdateUserGoogleIDParams) error
 	UpdateUserName(ctx context.Context, arg UpdateUserNameParams) error
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
 	UpdateUserPicture(ctx context.Context, arg UpdateUserPictureParams) error
 	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error
+	UseEventInvitationCode(ctx context.Context, arg UseEventInvitationCodeParams) (uuid.UUID, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -6,12 +6,13 @@ order by order_index;
 
 -- name: CreateEventChallengeCategory :exec
 insert into event_challenge_categories
-    (id, event_id, name, order_index)
-values ($1, $2, $3, $4);
+    (id, event_id, name, order_index, release_time)
+values ($1, $2, $3, $4, $5);
 
 -- name: UpdateEventChallengeCategory :exec
 update event_challenge_categories
-set name = $3
+set name         = $3,
+    release_time = $4
 where id = $1
   and event_id = $2;
 
@@ -25,4 +26,10 @@ where id = $1
 delete
 from event_challenge_categories
 where id = $1
-  and event_id = $2;
\ No newline at end of file
+  and event_id = $2;
+
+-- name: UpdateEventChallengeCategoryVisibility :exec
+update event_challenge_categories
+set visibility = $3
+where id = $1
+  and event_id = $2;
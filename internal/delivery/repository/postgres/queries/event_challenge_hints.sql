@@ -0,0 +1,25 @@
+-- name: CreateEventChallengeHint :exec
+insert into event_challenge_hints (id, event_id, challenge_id, order_index, content, cost)
+values ($1, $2, $3, $4, $5, $6);
+
+-- name: GetEventChallengesHints :many
+select *
+from event_challenge_hints
+where event_id = $1
+order by challenge_id, order_index;
+
+-- name: CreateEventTeamHintUnlock :exec
+insert into event_team_hint_unlocks (event_id, team_id, challenge_id, hint_id, cost, unlocked_by)
+values ($1, $2, $3, $4, $5, $6)
+on conflict do nothing;
+
+-- name: GetEventTeamUnlockedHintIDs :many
+select hint_id
+from event_team_hint_unlocks
+where event_id = $1
+  and team_id = $2;
+
+-- name: GetEventHintUnlocks :many
+select team_id, challenge_id, cost, created_at
+from event_team_hint_unlocks
+where event_id = $1;
@@ -0,0 +1,27 @@
+-- name: CreateEventChallengePrerequisite :exec
+insert into event_challenge_prerequisites (id, event_id, challenge_id, type, required_count, required_score)
+values ($1, $2, $3, $4, $5, $6);
+
+-- name: CreateEventChallengePrerequisiteChallenge :exec
+insert into event_challenge_prerequisite_challenges (prerequisite_id, challenge_id)
+values ($1, $2)
+on conflict do nothing;
+
+-- name: GetEventChallengesPrerequisites :many
+select *
+from event_challenge_prerequisites
+where event_id = $1
+order by challenge_id, created_at;
+
+-- name: GetEventChallengesPrerequisiteChallenges :many
+select event_challenge_prerequisite_challenges.prerequisite_id, event_challenge_prerequisite_challenges.challenge_id
+from event_challenge_prerequisite_challenges
+         inner join event_challenge_prerequisites
+                    on event_challenge_prerequisites.id = event_challenge_prerequisite_challenges.prerequisite_id
+where event_challenge_prerequisites.event_id = $1;
+
+-- name: DeleteEventChallengePrerequisites :exec
+delete
+from event_challenge_prerequisites
+where event_id = $1
+  and challenge_id = $2;
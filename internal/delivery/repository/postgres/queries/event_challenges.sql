@@ -31,4 +31,24 @@ where id = $1
 delete
 from event_challenges
 where exercise_id = $1
-  and event_id = $2;
\ No newline at end of file
+  and event_id = $2;
+
+-- name: UpdateEventChallengeReleaseTime :exec
+update event_challenges
+set release_time = $3
+where id = $1
+  and event_id = $2;
+
+-- name: UpdateEventChallengeVisibility :exec
+update event_challenges
+set visibility = $3
+where id = $1
+  and event_id = $2;
+
+-- name: UpdateEventChallengeScoring :exec
+update event_challenges
+set dynamic_min             = $3,
+    dynamic_max             = $4,
+    dynamic_solve_threshold = $5
+where id = $1
+  and event_id = $2;
@@ -0,0 +1,28 @@
+-- name: CreateEventCheatingIncident :exec
+insert into event_cheating_incidents (id, event_id, challenge_id, team_id, source_team_id, participant_id, answer)
+values ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetEventCheatingIncidents :many
+select event_cheating_incidents.id,
+       event_cheating_incidents.challenge_id,
+       event_challenges.name as challenge_name,
+       event_cheating_incidents.team_id,
+       team.name             as team_name,
+       event_cheating_incidents.source_team_id,
+       source_team.name      as source_team_name,
+       event_cheating_incidents.participant_id,
+       event_cheating_incidents.answer,
+       event_cheating_incidents.created_at
+from event_cheating_incidents
+         join event_challenges on event_challenges.id = event_cheating_incidents.challenge_id
+         join event_teams team on team.id = event_cheating_incidents.team_id
+         join event_teams source_team on source_team.id = event_cheating_incidents.source_team_id
+where event_cheating_incidents.event_id = $1
+order by event_cheating_incidents.created_at desc;
+
+-- name: EventTeamCheatingIncidentExists :one
+select exists(select true
+              from event_cheating_incidents
+              where event_id = $1
+                and challenge_id = $2
+                and team_id = $3) as exists;
@@ -0,0 +1,68 @@
+-- name: CreateEventInvitationCode :exec
+insert into event_invitation_codes (id, event_id, code, max_uses, expires_at, created_by)
+values ($1, $2, $3, $4, $5, $6);
+
+-- name: GetEventInvitationCodes :many
+select *
+from event_invitation_codes
+where event_id = $1
+order by created_at;
+
+-- name: DeleteEventInvitationCode :exec
+delete
+from event_invitation_codes
+where id = $1
+  and event_id = $2;
+
+-- name: UseEventInvitationCode :one
+update event_invitation_codes
+set uses = uses + 1
+where event_id = $1
+  and code = $2
+  and (max_uses = 0 or uses < max_uses)
+  and (expires_at is null or expires_at > now())
+returning id;
+
+-- name: CreateEventInvitation :exec
+insert into event_invitations (event_id, user_id, created_by)
+values ($1, $2, $3)
+on conflict do nothing;
+
+-- name: GetEventInvitations :many
+select event_invitations.user_id,
+       users.name,
+       users.email,
+       event_invitations.created_by,
+       event_invitations.created_at
+from event_invitations
+         join users on users.id = event_invitations.user_id
+where event_invitations.event_id = $1
+order by event_invitations.created_at;
+
+-- name: DeleteEventInvitation :exec
+delete
+from event_invitations
+where event_id = $1
+  and user_id = $2;
+
+-- name: EventInvitationExists :one
+select EXISTS(select true as exists from event_invitations where event_id = $1 and user_id = $2) as exists;
+
+-- name: GetUserInvitedEventIDs :many
+select event_id
+from event_invitations
+where user_id = $1;
+
+-- name: EventInvitationCodeValid :one
+select EXISTS(select true as exists
+              from event_invitation_codes
+              where event_id = $1
+                and code = $2
+                and (max_uses = 0 or uses < max_uses)
+                and (expires_at is null or expires_at > now())) as exists;
+
+-- name: ReleaseEventInvitationCodeUse :exec
+update event_invitation_codes
+set uses = uses - 1
+where id = $1
+  and uses > 0;
@@ -10,12 +10,55 @@ where event_id = $1
 
 -- name: UpdateEventParticipantStatus :exec
 update event_participants
-set approval_status = $3
+set approval_status = $3,
+    updated_at      = now(),
+    updated_by      = $4
 where event_id = $1
   and user_id = $2;
 
 -- name: UpdateEventParticipantTeam :exec
 update event_participants
-set team_id = $3
+set team_id        = $3,
+    team_joined_at = case when $3::uuid is null then null else now() end
 where event_id = $1
-  and user_id = $2;
\ No newline at end of file
+  and user_id = $2;
+
+-- name: GetEventParticipantsUserIDs :many
+select user_id
+from event_participants
+where event_id = $1;
+
+-- name: GetEventParticipants :many
+select event_participants.user_id,
+       users.name,
+       users.email,
+       event_participants.team_id,
+       event_teams.name as team_name,
+       event_participants.approval_status,
+       event_participants.updated_at,
+       event_participants.updated_by,
+       event_participants.created_at
+from event_participants
+         join users on users.id = event_participants.user_id
+         left join event_teams on event_teams.id = event_participants.team_id
+where event_participants.event_id = $1
+order by event_participants.created_at;
+
+-- name: CountEventActiveParticipants :one
+select count(*)
+from event_participants
+where event_id = $1
+  and approval_status in (1, 2);
+
+-- name: GetEventWaitlistedParticipants :many
+select user_id
+from event_participants
+where event_id = $1
+  and approval_status = 4
+order by created_at;
+
+-- name: DeleteEventParticipant :exec
+delete
+from event_participants
+where event_id = $1
+  and user_id = $2;
@@ -0,0 +1,24 @@
+-- name: CreateEventScoreAdjustment :exec
+insert into event_score_adjustments (id, event_id, team_id, points, reason, created_by)
+values ($1, $2, $3, $4, $5, $6);
+
+-- name: GetEventScoreAdjustments :many
+select *
+from event_score_adjustments
+where event_id = $1
+order by created_at;
+
+-- name: UpdateEventScoreAdjustment :exec
+update event_score_adjustments
+set points     = $3,
+    reason     = $4,
+    updated_at = now(),
+    updated_by = $5
+where id = $1
+  and event_id = $2;
+
+-- name: DeleteEventScoreAdjustment :exec
+delete
+from event_score_adjustments
+where id = $1
+  and event_id = $2;
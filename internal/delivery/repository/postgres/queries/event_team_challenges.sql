@@ -7,4 +7,23 @@ values ($1, $2, $3, $4, $5);
 select flag
 from event_team_challenges
 where challenge_id = $1
-  and team_id = $2;
\ No newline at end of file
+  and team_id = $2;
+
+-- name: GetEventTeamChallenges :many
+select id, challenge_id, flag
+from event_team_challenges
+where event_id = $1
+  and team_id = $2;
+
+-- name: DeleteEventTeamChallenge :exec
+delete
+from event_team_challenges
+where id = $1;
+
+-- name: GetChallengeFlagOwnerTeamID :one
+select team_id
+from event_team_challenges
+where challenge_id = $1
+  and flag = $2
+  and team_id <> $3
+limit 1;
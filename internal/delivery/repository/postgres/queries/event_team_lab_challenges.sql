@@ -0,0 +1,32 @@
+-- name: GetEventTeamLabChallenges :many
+select exercise_id
+from event_team_lab_challenges
+where team_id = $1;
+
+-- name: CreateEventTeamLabChallenge :exec
+insert into event_team_lab_challenges
+    (event_id, team_id, exercise_id)
+values ($1, $2, $3)
+on conflict do nothing;
+
+-- name: DeleteEventTeamLabChallenge :exec
+delete
+from event_team_lab_challenges
+where team_id = $1
+  and exercise_id = $2;
+
+-- name: DeleteEventTeamLabChallenges :exec
+delete
+from event_team_lab_challenges
+where team_id = $1;
+
+-- name: DeleteEventLabChallenges :exec
+delete
+from event_team_lab_challenges
+where event_id = $1
+  and exercise_id = $2;
+
+-- name: DeleteEventTeamsLabChallenges :exec
+delete
+from event_team_lab_challenges
+where event_id = $1;
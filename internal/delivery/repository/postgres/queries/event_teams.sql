@@ -4,18 +4,30 @@ from event_teams
 group by event_id;
 
 -- name: GetEventTeams :many
-select id, event_id, name, laboratory_id, updated_at, updated_by, created_at
+select id, event_id, name, laboratory_id, captain_id, disqualified, updated_at, updated_by, created_at
 from event_teams
 where event_id = $1;
 
+-- name: GetEventTeamByID :one
+select id, event_id, name, laboratory_id, captain_id, disqualified, updated_at, updated_by, created_at
+from event_teams
+where id = $1
+  and event_id = $2;
+
 -- name: TeamExistsInEvent :one
 select EXISTS(select true as exists from event_teams where name = $1 and event_id = $2) as exists;
 
 -- name: CreateTeamInEvent :exec
-insert into event_teams (id, name, join_code, event_id, laboratory_id)
-values ($1, $2, $3, $4, $5);
+insert into event_teams (id, name, join_code, event_id, laboratory_id, captain_id)
+values ($1, $2, $3, $4, $5, $6);
 
 
+-- name: UpdateEventTeamLaboratory :exec
+update event_teams
+set laboratory_id = $2,
+    updated_at    = now()
+where id = $1;
+
 -- name: GetEventTeamByName :one
 select id, name, join_code
 from event_teams
@@ -23,7 +35,7 @@ where name = $1
   and event_id = $2;
 
 -- name: GetEventParticipantTeam :one
-select event_teams.id, name, join_code, laboratory_id
+select event_teams.id, name, join_code, laboratory_id, captain_id, disqualified
 from event_teams
          join event_participants on event_teams.id = event_participants.team_id
 where event_participants.event_id = $1
@@ -35,4 +47,67 @@ from event_participants
 where event_id = $1
   and user_id = $2;
 
+-- name: DeleteEventTeamsLaboratories :exec
+update event_teams
+set laboratory_id = null,
+    updated_at    = now()
+where event_id = $1;
+
+-- name: UpdateEventTeamName :exec
+update event_teams
+set name       = $2,
+    updated_at = now(),
+    updated_by = $3
+where id = $1;
+
+-- name: UpdateEventTeamJoinCode :exec
+update event_teams
+set join_code  = $2,
+    updated_at = now(),
+    updated_by = $3
+where id = $1;
+
+-- name: UpdateEventTeamCaptain :exec
+update event_teams
+set captain_id = $2,
+    updated_at = now(),
+    updated_by = $3
+where id = $1;
+
+-- name: GetEventTeamMembers :many
+select event_participants.user_id, users.name
+from event_participants
+         join users on users.id = event_participants.user_id
+where event_participants.event_id = $1
+  and event_participants.team_id = $2
+order by event_participants.team_joined_at;
+
+-- name: CountEventTeams :one
+select count(*)
+from event_teams
+where event_id = $1;
+
+-- name: CountEventTeamMembers :one
+select count(*)
+from event_participants
+where team_id = $1;
+
+-- name: UpdateEventTeamDisqualified :exec
+update event_teams
+set disqualified = $2,
+    updated_at   = now(),
+    updated_by   = $3
+where id = $1;
+
+-- name: DeleteEventTeam :exec
+delete
+from event_teams
+where id = $1
+  and event_id = $2;
 
+-- name: EventTeamActivityExists :one
+select exists(select true from event_challenge_solution_attempts where event_challenge_solution_attempts.team_id = $1)
+           or exists(select true
+                     from event_cheating_incidents
+                     where event_cheating_incidents.team_id = $1
+                        or event_cheating_incidents.source_team_id = $1) as exists;
@@ -27,29 +27,52 @@ where tag = $1;
 -- name: CreateEvent :exec
 insert into events (id, type, availability, participation, tag, name, description, rules, picture, dynamic_scoring,
                     dynamic_max, dynamic_min, dynamic_solve_threshold, registration, scoreboard_availability,
-                    participants_visibility, publish_time, start_time, finish_time, withdraw_time)
-values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20);
+                    participants_visibility, publish_time, start_time, finish_time, withdraw_time,
+                    laboratories_grace_period, max_team_size, max_teams, hide_locked_challenges, solution_attempts_limit,
+                    solution_attempts_window, solution_attempts_cooldown, max_solution_attempts, flag_sharing_action,
+                    scoring_strategy, first_solve_bonus, second_solve_bonus, third_solve_bonus,
+                    scoreboard_freeze_time)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34);
 
 -- name: UpdateEvent :exec
 update events
-set name                    = $2,
-    description             = $3,
-    rules                   = $4,
-    picture                 = $5,
-    dynamic_scoring         = $6,
-    dynamic_max             = $7,
-    dynamic_min             = $8,
-    dynamic_solve_threshold = $9,
-    registration            = $10,
-    scoreboard_availability = $11,
-    participants_visibility = $12,
-    publish_time            = $13,
-    start_time              = $14,
-    finish_time             = $15,
-    withdraw_time           = $16
+set name                       = $2,
+    description                = $3,
+    rules                      = $4,
+    picture                    = $5,
+    dynamic_scoring            = $6,
+    dynamic_max                = $7,
+    dynamic_min                = $8,
+    dynamic_solve_threshold    = $9,
+    registration               = $10,
+    scoreboard_availability    = $11,
+    participants_visibility    = $12,
+    publish_time               = $13,
+    start_time                 = $14,
+    finish_time                = $15,
+    withdraw_time              = $16,
+    laboratories_grace_period  = $17,
+    max_team_size              = $18,
+    max_teams                  = $19,
+    hide_locked_challenges     = $20,
+    solution_attempts_limit    = $21,
+    solution_attempts_window   = $22,
+    solution_attempts_cooldown = $23,
+    max_solution_attempts      = $24,
+    flag_sharing_action        = $25,
+    scoring_strategy           = $26,
+    first_solve_bonus          = $27,
+    second_solve_bonus         = $28,
+    third_solve_bonus          = $29,
+    scoreboard_freeze_time     = $30
 where id = $1;
 
 -- name: DeleteEvent :exec
 delete
 from events
 where id = $1;
+
+-- name: UpdateEventScoreboardRevealed :exec
+update events
+set scoreboard_revealed = $2
+where id = $1;
@@ -0,0 +1,40 @@
+-- name: CreateJob :exec
+insert into jobs (id, job_type, key, payload, run_at)
+values ($1, $2, $3, $4, $5)
+on conflict (key) do update set job_type   = excluded.job_type,
+                                payload    = excluded.payload,
+                                run_at     = excluded.run_at,
+                                attempts   = 0,
+                                last_error = '',
+                                status     = 0,
+                                updated_at = now();
+
+-- name: GetJobByKey :one
+select *
+from jobs
+where key = $1;
+
+-- name: GetJobsByStatus :many
+select *
+from jobs
+where status = $1;
+
+-- name: UpdateJobAttempt :exec
+update jobs
+set run_at     = $2,
+    attempts   = $3,
+    last_error = $4,
+    status     = $5,
+    updated_at = now()
+where id = $1;
+
+-- name: DeleteJob :exec
+delete
+from jobs
+where id = $1
+  and run_at = $2;
+
+-- name: DeleteJobByKey :exec
+delete
+from jobs
+where key = $1;
@@ -18,6 +18,12 @@ type (
 		Username string
 		Link     string
 	}
+
+	WaitlistPromotionTemplateData struct {
+		Username  string
+		EventName string
+		Link      string
+	}
 )
 
 const (
@@ -25,6 +31,7 @@ const (
 	ContinueRegistrationTemplate = "continue_registration_template"
 	PasswordResettingTemplate    = "password_resetting_template"
 	EmailConfirmationTemplate    = "email_confirmation_template"
+	WaitlistPromotionTemplate    = "waitlist_promotion_template"
 )
 
 const (
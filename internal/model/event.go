@@ -25,16 +25,39 @@ type (
 		DynamicMaxScore       int32
 		DynamicMinScore       int32
 		DynamicSolveThreshold int32
+		ScoringStrategy       int32 // formula of the dynamic scoring
+
+		// extra points for the first, second and third solve of the challenge
+		FirstSolveBonus  int32
+		SecondSolveBonus int32
+		ThirdSolveBonus  int32
 
 		Registration           int32
 		ScoreboardAvailability int32
 		ParticipantsVisibility int32
 
+		ScoreboardFreezeTime *time.Time // nil is not frozen, participants see the standings at this time after it
+		ScoreboardRevealed   bool       // the frozen standings are revealed by the organizers
+
 		PublishTime  time.Time
 		StartTime    time.Time
 		FinishTime   time.Time
 		WithdrawTime time.Time
 
+		LaboratoriesGracePeriod int32 // in minutes after the finish time
+
+		MaxTeamSize int32 // 0 is unlimited
+		MaxTeams    int32 // 0 is unlimited
+
+		HideLockedChallenges bool // locked challenges are hidden instead of shown as locked
+
+		SolutionAttemptsLimit    int32 // attempts per window for the team on the challenge, 0 is unlimited
+		SolutionAttemptsWindow   int32 // in seconds
+		SolutionAttemptsCooldown int32 // in seconds after the limit is reached
+		MaxSolutionAttempts      int32 // total attempts for the team on the challenge, 0 is unlimited
+
+		FlagSharingAction int32 // how the teams submitting flags of other teams are handled
+
 		CreatedAt time.Time
 
 		ChallengesCount int64
@@ -57,6 +80,9 @@ type (
 
 		StartTime  time.Time
 		FinishTime time.Time
+
+		ScoreboardFreezeTime *time.Time
+		ScoreboardRevealed   bool
 	}
 
 	ChallengeCategory struct {
@@ -66,6 +92,9 @@ type (
 		Name  string
 		Order int32
 
+		Visibility  int32
+		ReleaseTime *time.Time // nil is released with the event start
+
 		CreatedAt time.Time
 	}
 
@@ -81,11 +110,29 @@ type (
 		Description string
 		Points      int32
 
+		// dynamic scoring overrides, nil is the event value
+		DynamicMaxScore       *int32
+		DynamicMinScore       *int32
+		DynamicSolveThreshold *int32
+
 		Order int32
 
+		Visibility  int32
+		ReleaseTime *time.Time // nil is released with the category
+
 		CreatedAt time.Time
 	}
 
+	ChallengePrerequisite struct {
+		ID          uuid.UUID
+		ChallengeID uuid.UUID
+
+		Type          int32
+		ChallengeIDs  []uuid.UUID // for challenge solved and challenges count types
+		RequiredCount int32       // for challenges count type
+		RequiredScore int32       // for score type
+	}
+
 	Order struct {
 		ID         uuid.UUID
 		CategoryID uuid.UUID
@@ -101,14 +148,72 @@ type (
 
 		LaboratoryID uuid.NullUUID
 
+		CaptainID uuid.NullUUID
+		Members   []*TeamMember
+
+		Disqualified bool
+
 		CreatedAt time.Time
 	}
 
+	TeamMember struct {
+		UserID uuid.UUID
+		Name   string
+	}
+
 	TeamInfo struct {
 		ID   uuid.UUID
 		Name string
 	}
 
+	Participant struct {
+		UserID uuid.UUID
+		Name   string
+		Email  string
+
+		TeamID   uuid.NullUUID
+		TeamName string
+
+		ApprovalStatus int32
+
+		UpdatedAt *time.Time
+		UpdatedBy uuid.NullUUID
+		CreatedAt time.Time
+	}
+
+	InvitationCode struct {
+		ID      uuid.UUID
+		EventID uuid.UUID
+
+		Code      string
+		MaxUses   int32 // 0 is unlimited
+		Uses      int32
+		ExpiresAt *time.Time // nil never expires
+
+		CreatedBy uuid.NullUUID
+		CreatedAt time.Time
+	}
+
+	Invitation struct {
+		UserID uuid.UUID
+		Name   string
+		Email  string
+
+		CreatedBy uuid.NullUUID
+		CreatedAt time.Time
+	}
+
+	TeamParticipantsInfo struct {
+		ID      uuid.UUID
+		Name    string
+		Members []*ParticipantInfo
+	}
+
+	ParticipantInfo struct {
+		Name  string
+		Email string // only for administrators
+	}
+
 	CategoryInfo struct {
 		ID         uuid.UUID
 		Name       string
@@ -121,7 +226,63 @@ type (
 		Description string
 		Points      int32
 
-		Solved bool
+		Bonus int32 // extra points for the next solve
+
+		Solved     bool
+		FirstBlood bool // the team solved the challenge first
+		Locked     bool // locked challenges are shown without description and hints
+		Hints      []*HintInfo
+	}
+
+	ChallengeHint struct {
+		ID          uuid.UUID
+		ChallengeID uuid.UUID
+		Order       int32
+		Content     string
+		Cost        int32
+	}
+
+	HintInfo struct {
+		ID       uuid.UUID
+		Cost     int32
+		Unlocked bool
+		Content  string // only for unlocked hints
+	}
+
+	SolutionAttemptResult struct {
+		Solved            bool
+		RemainingAttempts int32      // attempts left before the team is blocked, -1 is unlimited
+		RetryAfter        *time.Time // set if the team is blocked
+	}
+
+	CheatingIncident struct {
+		ID            uuid.UUID
+		ChallengeID   uuid.UUID
+		ChallengeName string
+
+		TeamID         uuid.UUID // team submitted the shared flag
+		TeamName       string
+		SourceTeamID   uuid.UUID // team the flag belongs to
+		SourceTeamName string
+
+		ParticipantID uuid.NullUUID
+		Answer        string
+
+		CreatedAt time.Time
+	}
+
+	ScoreAdjustment struct {
+		ID      uuid.UUID
+		EventID uuid.UUID
+		TeamID  uuid.UUID
+
+		Points int32 // positive is an award, negative is a penalty
+		Reason string
+
+		UpdatedAt *time.Time
+		UpdatedBy uuid.NullUUID
+		CreatedBy uuid.NullUUID
+		CreatedAt time.Time
 	}
 
 	ChallengeSoledBy struct {
@@ -142,29 +303,61 @@ type (
 
 	TeamScore struct {
 		Rank              int
+		TeamID            uuid.UUID
 		TeamName          string
 		Score             int
 		TeamSolutions     map[uuid.UUID]TeamSolution
 		LatestSolution    time.Time
-		TeamScoreTimeline [][]interface{}
+		TeamScoreTimeline []TimelineEvent
 	}
 
 	TeamSolution struct {
-		ID   uuid.UUID
-		Rank int
+		ID       uuid.UUID
+		Rank     int
+		Bonus    int
+		SolvedAt time.Time
+	}
+
+	ScoreFeedEvent struct {
+		Type  int32
+		Score *EventScore // for the score updates
+		Solve *SolveInfo  // for the solves and first bloods
 	}
 
-	SolutionForTimeline struct {
-		Date   time.Time
-		Points int
+	SolveInfo struct {
+		ChallengeID   uuid.UUID
+		ChallengeName string
+		TeamID        uuid.UUID
+		TeamName      string
+		Rank          int
+		SolvedAt      time.Time
+	}
+
+	// TimelineEvent is the change of the team score, the timeline starts with the event start
+	TimelineEvent struct {
+		Type        int32
+		Time        time.Time
+		Points      int       // points gained or lost by the change
+		Score       int       // team score after the change
+		ChallengeID uuid.UUID // for the solves and hint unlocks
+		Reason      string    // for the score adjustments
 	}
 )
 
 var (
-	ErrEventAlreadyJoined      = tools.NewError("event already joined", http.StatusConflict)
-	ErrEventRegistrationClosed = tools.NewError("event registration is closed", http.StatusForbidden)
-	ErrEventNotJoined          = tools.NewError("event not joined", http.StatusForbidden)
-	ErrScoreNotAvailable       = tools.NewError("score not available", http.StatusForbidden)
+	ErrEventNotFound            = tools.NewError("event not found", http.StatusNotFound)
+	ErrEventAlreadyJoined       = tools.NewError("event already joined", http.StatusConflict)
+	ErrEventRegistrationClosed  = tools.NewError("event registration is closed", http.StatusForbidden)
+	ErrEventNotJoined           = tools.NewError("event not joined", http.StatusForbidden)
+	ErrParticipantNotFound      = tools.NewError("participant not found", http.StatusNotFound)
+	ErrScoreNotAvailable        = tools.NewError("score not available", http.StatusForbidden)
+	ErrScoringStrategyInvalid   = tools.NewError("scoring strategy is invalid", http.StatusBadRequest)
+	ErrScoreboardFreezeInvalid  = tools.NewError("scoreboard freeze time is invalid", http.StatusBadRequest)
+	ErrScoreAdjustmentInvalid   = tools.NewError("score adjustment is invalid", http.StatusBadRequest)
+	ErrScoreExportFormatInvalid = tools.NewError("score export format is invalid", http.StatusBadRequest)
+	ErrParticipantsNotVisible   = tools.NewError("participants not visible", http.StatusForbidden)
+
+	ErrInvitationCodeInvalid = tools.NewError("invitation code is invalid", http.StatusForbidden)
 
 	ErrTeamExists           = tools.NewError("team exists", http.StatusConflict)
 	ErrUserAlreadyInTeam    = tools.NewError("user already in team", http.StatusConflict)
@@ -172,8 +365,38 @@ var (
 	ErrTeamNotFound         = tools.NewError("team not found", http.StatusNotFound)
 	ErrLaboratoryNotFound   = tools.NewError("laboratory not found", http.StatusNotFound)
 
-	ErrSolutionAttemptNotAllowed = tools.NewError("solution attempt not allowed", http.StatusForbidden)
-	ErrIncorrectSolution         = tools.NewError("incorrect solution", http.StatusBadRequest)
+	ErrEventTeamsLimitReached = tools.NewError("event teams limit reached", http.StatusConflict)
+	ErrEventFull              = tools.NewError("event places are taken", http.StatusConflict)
+	ErrTeamFull               = tools.NewError("team is full", http.StatusConflict)
+
+	ErrTeamManagementNotAllowed = tools.NewError("team management is not allowed for individual participation", http.StatusForbidden)
+	ErrTeamCaptainRequired      = tools.NewError("only team captain can manage team", http.StatusForbidden)
+	ErrTeamMemberNotFound       = tools.NewError("team member not found", http.StatusNotFound)
+	ErrTeamMembershipLocked     = tools.NewError("team membership is locked after event start", http.StatusForbidden)
+	ErrTeamDisqualified         = tools.NewError("team is disqualified", http.StatusForbidden)
+
+	ErrEventLaboratoriesReleased = tools.NewError("event laboratories are released", http.StatusConflict)
+
+	ErrChallengeTaskNotFound = tools.NewError("challenge task not found", http.StatusNotFound)
+
+	ErrSolutionAttemptNotAllowed    = tools.NewError("solution attempt not allowed", http.StatusForbidden)
+	ErrIncorrectSolution            = tools.NewError("incorrect solution", http.StatusBadRequest)
+	ErrSolutionAttemptsThrottled    = tools.NewError("too many solution attempts", http.StatusTooManyRequests)
+	ErrSolutionAttemptsExhausted    = tools.NewError("solution attempts exhausted", http.StatusForbidden)
+	ErrSolutionAttemptsLimitInvalid = tools.NewError("solution attempts limit is invalid", http.StatusBadRequest)
+	ErrFlagSharingDetected          = tools.NewError("flag of another team is submitted", http.StatusForbidden)
+
+	ErrChallengeNotFound            = tools.NewError("challenge not found", http.StatusNotFound)
+	ErrChallengeVisibilityInvalid   = tools.NewError("challenge visibility is invalid", http.StatusBadRequest)
+	ErrChallengeNotReleased         = tools.NewError("challenge is not released", http.StatusForbidden)
+	ErrChallengeLocked              = tools.NewError("challenge is locked", http.StatusForbidden)
+	ErrChallengeAlreadySolved       = tools.NewError("challenge is already solved", http.StatusConflict)
+	ErrChallengePrerequisiteInvalid = tools.NewError("challenge prerequisite is invalid", http.StatusBadRequest)
+	ErrChallengeScoringInvalid      = tools.NewError("challenge scoring is invalid", http.StatusBadRequest)
+
+	ErrHintNotFound         = tools.NewError("hint not found", http.StatusNotFound)
+	ErrHintUnlockOrder      = tools.NewError("previous hints must be unlocked first", http.StatusConflict)
+	ErrHintUnlockNotAllowed = tools.NewError("hint unlock not allowed", http.StatusForbidden)
 )
 
 // Event types
@@ -195,6 +418,7 @@ const (
 	PendingParticipationStatus
 	ApprovedParticipationStatus
 	RejectedParticipationStatus
+	WaitlistedParticipationStatus
 )
 
 // Event participation types
@@ -216,6 +440,49 @@ const (
 	HiddenScoreboardAvailabilityType
 )
 
+// Dynamic scoring strategies
+const (
+	QuadraticScoringStrategy   = int32(iota) // points decrease quadratically to the minimum at the solve threshold
+	LogarithmicScoringStrategy               // CTFd-like curve, points decrease fast after the first solves
+	LinearScoringStrategy                    // points decrease linearly to the minimum at the solve threshold
+)
+
+// Score feed event types
+const (
+	ScoreUpdatedFeedEventType = int32(iota)
+	SolveFeedEventType
+	FirstBloodFeedEventType
+)
+
+// Score timeline event types
+const (
+	StartTimelineEventType = int32(iota)
+	SolveTimelineEventType
+	HintUnlockTimelineEventType
+	AdjustmentTimelineEventType
+)
+
+// Flag sharing actions
+const (
+	LogFlagSharingAction = int32(iota)
+	RejectFlagSharingAction
+	DisqualifyFlagSharingAction
+)
+
+// Challenge visibility types
+const (
+	DraftChallengeVisibilityType = int32(iota)
+	VisibleChallengeVisibilityType
+	ArchivedChallengeVisibilityType
+)
+
+// Challenge prerequisite types
+const (
+	ChallengeSolvedPrerequisiteType = int32(iota) // all listed challenges are solved
+	ChallengesCountPrerequisiteType               // required count of the listed challenges is solved
+	ScorePrerequisiteType                         // team score reaches the required score
+)
+
 // Event participants visibility types
 const (
 	PublicParticipantsVisibilityType = int32(iota)
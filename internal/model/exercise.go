@@ -1,7 +1,9 @@
 package model
 
 import (
+	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gofrs/uuid"
+	"net/http"
 	"time"
 )
 
@@ -29,7 +31,15 @@ type (
 		LinkedInstanceID uuid.NullUUID
 		InstanceFlagVar  string
 
-		Flags []string // len(0) - random, len(1) - static, len(>1) - from list
+		Flags         []string // len(0) - random, len(1) - static, len(>1) - from list
+		FlagMatchMode int32    // how the answer is compared with the flag, random flags are always matched exactly
+
+		Hints []Hint // in the order they are unlocked
+	}
+
+	Hint struct {
+		Content string
+		Cost    int32 // 0 - free
 	}
 
 	Instance struct {
@@ -63,3 +73,17 @@ type (
 		CreatedAt   time.Time
 	}
 )
+
+var (
+	ErrExerciseFlagMatchModeInvalid = tools.NewError("exercise flag match mode is invalid", http.StatusBadRequest)
+	ErrExerciseFlagPatternInvalid   = tools.NewError("exercise flag pattern is invalid", http.StatusBadRequest)
+)
+
+// Flag match modes
+const (
+	ExactFlagMatchMode           = int32(iota)
+	CaseInsensitiveFlagMatchMode // letter case is ignored
+	TrimmedFlagMatchMode         // surrounding whitespaces are ignored
+	RegexFlagMatchMode           // the flag is a regular expression the whole answer has to match
+	AnyOfFlagMatchMode           // any flag from the list is accepted
+)
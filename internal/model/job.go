@@ -0,0 +1,46 @@
+package model
+
+import (
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+	"net/http"
+	"time"
+)
+
+type (
+	Job struct {
+		ID uuid.UUID
+
+		Type    int32
+		Key     string
+		Payload []byte
+
+		RunAt     time.Time
+		Attempts  int32
+		LastError string
+		Status    int32
+
+		CreatedAt time.Time
+	}
+
+	EventJobPayload struct {
+		EventID uuid.UUID
+	}
+)
+
+var (
+	ErrJobNotFound    = tools.NewError("job not found", http.StatusNotFound)
+	ErrUnknownJobType = tools.NewError("unknown job type", http.StatusInternalServerError)
+)
+
+// Job types
+const (
+	ReconcileEventChallengesJobType = int32(iota)
+	ReleaseEventResourcesJobType
+)
+
+// Job statuses
+const (
+	PendingJobStatus = int32(iota)
+	FailedJobStatus
+)
@@ -119,6 +119,28 @@ func (s *EmailService) SendEmailConfirmationEmail(ctx context.Context, sendTo st
 	return nil
 }
 
+func (s *EmailService) SendWaitlistPromotionEmail(ctx context.Context, sendTo string, data model.WaitlistPromotionTemplateData) error {
+	subjectT, bodyT, err := s.getTemplate(ctx, model.WaitlistPromotionTemplate)
+	if err != nil {
+		return err
+	}
+
+	subject, err := s.populatedWithData(subjectT, data)
+	if err != nil {
+		return err
+	}
+
+	body, err := s.populatedWithData(bodyT, data)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repository.SendEmail(sendTo, subject, body); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s *EmailService) getTemplate(ctx context.Context, templateName string) (string, string, error) {
 	// get email template
 	body, err := s.repository.GetEmailTemplateBody(ctx, templateName)
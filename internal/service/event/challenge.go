@@ -2,12 +2,16 @@ package event
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gofrs/uuid"
-	"github.com/hashicorp/go-multierror"
+	"regexp"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -19,18 +23,21 @@ type (
 		GetEventChallengeByID(ctx context.Context, params postgres.GetEventChallengeByIDParams) (postgres.EventChallenge, error)
 		DeleteEventChallenges(ctx context.Context, arg postgres.DeleteEventChallengesParams) error
 		UpdateEventChallengeOrder(ctx context.Context, arg postgres.UpdateEventChallengeOrderParams) error
+		UpdateEventChallengeReleaseTime(ctx context.Context, arg postgres.UpdateEventChallengeReleaseTimeParams) error
+		UpdateEventChallengeVisibility(ctx context.Context, arg postgres.UpdateEventChallengeVisibilityParams) error
+		UpdateEventChallengeScoring(ctx context.Context, arg postgres.UpdateEventChallengeScoringParams) error
 
 		WithTransaction(ctx context.Context) (withTx interface{}, commit func(), rollback func(), err error)
 
 		GetTeamsSolvedChallengeInEvent(ctx context.Context, arg postgres.GetTeamsSolvedChallengeInEventParams) ([]postgres.GetTeamsSolvedChallengeInEventRow, error)
 
 		GetChallengeFlag(ctx context.Context, arg postgres.GetChallengeFlagParams) (string, error)
+		GetChallengeFlagOwnerTeamID(ctx context.Context, arg postgres.GetChallengeFlagOwnerTeamIDParams) (uuid.UUID, error)
 		CreateEventChallengeSolutionAttempt(ctx context.Context, arg postgres.CreateEventChallengeSolutionAttemptParams) error
+		GetTeamChallengeSolutionAttemptTimes(ctx context.Context, arg postgres.GetTeamChallengeSolutionAttemptTimesParams) ([]time.Time, error)
 
-		CreateEventTeamChallenge(ctx context.Context, arg postgres.CreateEventTeamChallengeParams) error
-
-		AddLabChallenges(ctx context.Context, labID uuid.UUID, configs []model.LabChallenge) error
 		DeleteLabsChallenges(ctx context.Context, labIDs []uuid.UUID, exerciseIDs []uuid.UUID) error
+		DeleteEventLabChallenges(ctx context.Context, arg postgres.DeleteEventLabChallengesParams) error
 	}
 
 	IExerciseService interface {
@@ -46,7 +53,7 @@ func (s *EventService) GetEventChallenges(ctx context.Context, eventID uuid.UUID
 
 	result := make([]*model.Challenge, 0, len(challenges))
 	for _, challenge := range challenges {
-		result = append(result, &model.Challenge{
+		c := &model.Challenge{
 			ID:             challenge.ID,
 			EventID:        challenge.EventID,
 			CategoryID:     challenge.CategoryID,
@@ -55,9 +62,21 @@ func (s *EventService) GetEventChallenges(ctx context.Context, eventID uuid.UUID
 			Name:           challenge.Name,
 			Description:    challenge.Description,
 			Points:         challenge.Points,
-			Order:          challenge.OrderIndex,
-			CreatedAt:      challenge.CreatedAt,
-		})
+
+			DynamicMaxScore:       fromNullInt32(challenge.DynamicMax),
+			DynamicMinScore:       fromNullInt32(challenge.DynamicMin),
+			DynamicSolveThreshold: fromNullInt32(challenge.DynamicSolveThreshold),
+
+			Order:      challenge.OrderIndex,
+			Visibility: challenge.Visibility,
+			CreatedAt:  challenge.CreatedAt,
+		}
+
+		if challenge.ReleaseTime.Valid {
+			c.ReleaseTime = &challenge.ReleaseTime.Time
+		}
+
+		result = append(result, c)
 	}
 
 	return result, nil
@@ -69,10 +88,13 @@ func (s *EventService) GetEventChallengeByID(ctx context.Context, eventID uuid.U
 		ID:      challengeID,
 	})
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrChallengeNotFound
+		}
 		return nil, err
 	}
 
-	return &model.Challenge{
+	result := &model.Challenge{
 		ID:             challenge.ID,
 		EventID:        challenge.EventID,
 		CategoryID:     challenge.CategoryID,
@@ -81,9 +103,21 @@ func (s *EventService) GetEventChallengeByID(ctx context.Context, eventID uuid.U
 		Name:           challenge.Name,
 		Description:    challenge.Description,
 		Points:         challenge.Points,
-		Order:          challenge.OrderIndex,
-		CreatedAt:      challenge.CreatedAt,
-	}, nil
+
+		DynamicMaxScore:       fromNullInt32(challenge.DynamicMax),
+		DynamicMinScore:       fromNullInt32(challenge.DynamicMin),
+		DynamicSolveThreshold: fromNullInt32(challenge.DynamicSolveThreshold),
+
+		Order:      challenge.OrderIndex,
+		Visibility: challenge.Visibility,
+		CreatedAt:  challenge.CreatedAt,
+	}
+
+	if challenge.ReleaseTime.Valid {
+		result.ReleaseTime = &challenge.ReleaseTime.Time
+	}
+
+	return result, nil
 }
 
 func (s *EventService) GetEventChallengeSolvedBy(ctx context.Context, eventID, challengeID uuid.UUID) (*model.ChallengeSoledBy, error) {
@@ -110,6 +144,35 @@ func (s *EventService) GetEventChallengeSolvedBy(ctx context.Context, eventID, c
 	}, nil
 }
 
+// GetFrozenEventChallengeSolvedBy returns the teams solved the challenge before the scoreboard freeze
+// and the given team, if it solved the challenge after the freeze
+func (s *EventService) GetFrozenEventChallengeSolvedBy(ctx context.Context, eventID, challengeID, teamID uuid.UUID) (*model.ChallengeSoledBy, error) {
+	var teams []*model.TeamSolvedChallenge
+	if err := s.withScoreState(ctx, eventID, func(state *eventScoreState) {
+		teamNames := make(map[uuid.UUID]string, len(state.teams))
+		for _, team := range state.teams {
+			teamNames[team.ID] = team.Name
+		}
+
+		solutions := frozenScoreState(state, teamID).solutionsByChallenges[challengeID]
+		teams = make([]*model.TeamSolvedChallenge, 0, len(solutions))
+		for _, solution := range solutions {
+			teams = append(teams, &model.TeamSolvedChallenge{
+				ID:       solution.TeamID,
+				Name:     teamNames[solution.TeamID],
+				SolvedAt: solution.Timestamp,
+			})
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return &model.ChallengeSoledBy{
+		ChallengeID: challengeID,
+		Teams:       teams,
+	}, nil
+}
+
 func (s *EventService) AddExercisesToEvent(ctx context.Context, eventID, categoryID uuid.UUID, exerciseIDs []uuid.UUID) error {
 	ch, err := s.repository.GetEventChallenges(ctx, eventID)
 	count := len(ch)
@@ -125,8 +188,9 @@ func (s *EventService) AddExercisesToEvent(ctx context.Context, eventID, categor
 		}
 
 		for _, task := range exercise.Data.Tasks {
+			challengeID := uuid.Must(uuid.NewV7())
 			if err = s.repository.CreateEventChallenge(ctx, postgres.CreateEventChallengeParams{
-				ID:             uuid.Must(uuid.NewV7()),
+				ID:             challengeID,
 				EventID:        eventID,
 				CategoryID:     categoryID,
 				Name:           task.Name,
@@ -139,126 +203,15 @@ func (s *EventService) AddExercisesToEvent(ctx context.Context, eventID, categor
 
 				return err
 			}
-			count++
-		}
-	}
-
-	return nil
-}
-
-func (s *EventService) CreateEventTeamsChallenges(ctx context.Context, eventID uuid.UUID) error {
-	var errs error
-
-	// get all teams in event
-	teams, err := s.repository.GetEventTeams(ctx, eventID)
-	if err != nil {
-		return err
-	}
-
-	// get all challenges in event
-	challenges, err := s.repository.GetEventChallenges(ctx, eventID)
-	if err != nil {
-		return err
-	}
-
-	// create team challenges
-	for _, team := range teams {
-
-		flags := make(map[uuid.UUID]string)
-		// map[exerciseID][]instance
-		exeInstances := make(map[uuid.UUID][]model.Instance)
-	chF:
-		for _, challenge := range challenges {
-
-			// get exercise task
-			exercise, err := s.exerciseService.GetExercise(ctx, challenge.ExerciseID)
-			if err != nil {
-				errs = multierror.Append(errs, err)
-				continue chF
-			}
-
-			//if exercise has instances save them
-			if _, ok := exeInstances[challenge.ExerciseID]; !ok {
-				exeInstances[challenge.ExerciseID] = make([]model.Instance, 0)
-			}
 
-			if len(exercise.Data.Instances) > 0 {
-				for _, instance := range exercise.Data.Instances {
-					exeInstances[challenge.ExerciseID] = append(exeInstances[challenge.ExerciseID], model.Instance{
-						ID:    instance.ID,
-						Name:  instance.Name,
-						Image: instance.Image,
-						LinkedTaskID: uuid.NullUUID{
-							UUID:  instance.LinkedTaskID.UUID,
-							Valid: instance.LinkedTaskID.Valid,
-						},
-						InstanceFlagVar: instance.InstanceFlagVar,
-						EnvVars:         instance.EnvVars,
-						DNSRecords:      instance.DNSRecords,
-					})
-				}
-			}
-
-			// find task for challenge
-			for _, task := range exercise.Data.Tasks {
-				if task.ID == challenge.ExerciseTaskID {
-					flag, err := tools.GetSolutionForTask(task.Flags...)
-					if err != nil {
-						errs = multierror.Append(errs, err)
-						continue chF
-					}
-
-					if err = s.repository.CreateEventTeamChallenge(ctx, postgres.CreateEventTeamChallengeParams{
-						ID:          uuid.Must(uuid.NewV7()),
-						EventID:     eventID,
-						TeamID:      team.ID,
-						ChallengeID: challenge.ID,
-						Flag:        flag,
-					}); err != nil {
-						errs = multierror.Append(errs, err)
-						continue chF
-					}
-					// save flag
-					flags[task.ID] = flag
-					break
-				}
-			}
-		}
-
-		labChallenges := make([]model.LabChallenge, 0)
-
-		for exID, insts := range exeInstances {
-			for index, inst := range insts {
-				// if instance has flag var add it to envs
-				if inst.LinkedTaskID.Valid {
-					// get instance envs
-					envs := inst.EnvVars
-					// add flag to envs
-					envs = append(envs, model.EnvVar{
-						Name:  inst.InstanceFlagVar,
-						Value: flags[inst.LinkedTaskID.UUID],
-					})
-					// set updated envs to instance
-					exeInstances[exID][index].EnvVars = envs
-				}
+			if err = s.createEventChallengeHints(ctx, eventID, challengeID, task.Hints); err != nil {
+				return err
 			}
-
-			labChallenges = append(labChallenges, model.LabChallenge{
-				ID:        exID,
-				Instances: insts,
-			})
-		}
-
-		// create instances for team
-		if err = s.repository.AddLabChallenges(ctx, team.LaboratoryID.UUID, labChallenges); err != nil {
-			errs = multierror.Append(errs, err)
+			count++
 		}
-
 	}
 
-	if errs != nil {
-		return errs
-	}
+	s.scores.invalidate(eventID)
 
 	return nil
 }
@@ -281,6 +234,14 @@ func (s *EventService) DeleteEventTeamsChallenges(ctx context.Context, eventID,
 		return err
 	}
 
+	// forget the exercise deployments, so the reconciliation does not consider them
+	if err = s.repository.DeleteEventLabChallenges(ctx, postgres.DeleteEventLabChallengesParams{
+		EventID:    eventID,
+		ExerciseID: exerciseID,
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -292,6 +253,8 @@ func (s *EventService) DeleteEventChallenges(ctx context.Context, eventID uuid.U
 		return err
 	}
 
+	s.scores.invalidate(eventID)
+
 	return nil
 }
 
@@ -309,6 +272,8 @@ func (s *EventService) UpdateEventChallengesOrder(ctx context.Context, eventID u
 		}
 	}
 
+	s.scores.invalidate(eventID)
+
 	return nil
 }
 
@@ -328,11 +293,28 @@ func (s *EventService) SolveChallenge(ctx context.Context, eventID, teamID, chal
 		return false, err
 	}
 
-	// check if flag is correct
-	// Check if the solution is correct
-	isCorrect := strings.Compare(flag, solutionAttempt) == 0
+	task, err := s.getChallengeTask(ctx, eventID, challengeID)
+	if err != nil {
+		return false, err
+	}
+
+	// check if the solution is correct
+	isCorrect := isCorrectSolution(task, flag, solutionAttempt)
+
+	// random flags are unique for each team, so the flag of another team proves it is shared
+	sourceTeamID := uuid.Nil
+	if !isCorrect && len(task.Flags) == 0 {
+		if sourceTeamID, err = s.repository.GetChallengeFlagOwnerTeamID(ctx, postgres.GetChallengeFlagOwnerTeamIDParams{
+			ChallengeID: challengeID,
+			Flag:        solutionAttempt,
+			TeamID:      teamID,
+		}); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return false, err
+		}
+	}
 
 	// save attempt
+	timestamp := time.Now().UTC()
 	if err = s.repository.CreateEventChallengeSolutionAttempt(ctx, postgres.CreateEventChallengeSolutionAttemptParams{
 		ID:            uuid.Must(uuid.NewV7()),
 		EventID:       eventID,
@@ -342,10 +324,145 @@ func (s *EventService) SolveChallenge(ctx context.Context, eventID, teamID, chal
 		Answer:        solutionAttempt,
 		Flag:          flag,
 		IsCorrect:     isCorrect,
-		Timestamp:     time.Now().UTC(),
+		Timestamp:     timestamp,
 	}); err != nil {
 		return false, err
 	}
 
+	if isCorrect {
+		s.scores.addSolution(eventID, postgres.GetAllChallengesSolutionsInEventRow{
+			ChallengeID:   challengeID,
+			TeamID:        teamID,
+			ParticipantID: userID,
+			Timestamp:     timestamp,
+		})
+	}
+
+	if !sourceTeamID.IsNil() {
+		if err = s.repository.CreateEventCheatingIncident(ctx, postgres.CreateEventCheatingIncidentParams{
+			ID:            uuid.Must(uuid.NewV7()),
+			EventID:       eventID,
+			ChallengeID:   challengeID,
+			TeamID:        teamID,
+			SourceTeamID:  sourceTeamID,
+			ParticipantID: uuid.NullUUID{UUID: userID, Valid: true},
+			Answer:        solutionAttempt,
+		}); err != nil {
+			return false, err
+		}
+
+		return false, model.ErrFlagSharingDetected
+	}
+
 	return isCorrect, nil
 }
+
+func (s *EventService) getChallengeTask(ctx context.Context, eventID, challengeID uuid.UUID) (*model.Task, error) {
+	challenge, err := s.GetEventChallengeByID(ctx, eventID, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	exercise, err := s.exerciseService.GetExercise(ctx, challenge.ExerciseID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range exercise.Data.Tasks {
+		if task.ID == challenge.ExerciseTaskID {
+			return &task, nil
+		}
+	}
+
+	return nil, model.ErrChallengeTaskNotFound
+}
+
+// isCorrectSolution compares the solution attempt with the task flags according to the task flag match mode
+func isCorrectSolution(task *model.Task, flag, solutionAttempt string) bool {
+	// random flags are generated for each team, so they are matched exactly as the exact static flag of the team
+	if len(task.Flags) == 0 || task.FlagMatchMode == model.ExactFlagMatchMode {
+		return flag == solutionAttempt
+	}
+
+	// the team flag is one of the task flags, so the attempt is matched against all of them
+	return slices.ContainsFunc(task.Flags, func(taskFlag string) bool {
+		switch task.FlagMatchMode {
+		case model.CaseInsensitiveFlagMatchMode:
+			return strings.EqualFold(taskFlag, solutionAttempt)
+		case model.TrimmedFlagMatchMode:
+			return strings.TrimSpace(taskFlag) == strings.TrimSpace(solutionAttempt)
+		case model.RegexFlagMatchMode:
+			return matchFlagPattern(taskFlag, solutionAttempt)
+		default: // any of the flags
+			return taskFlag == solutionAttempt
+		}
+	})
+}
+
+// flagPatterns caches the compiled regular expression flags, so they are not compiled on each attempt
+var flagPatterns sync.Map // pattern -> *regexp.Regexp
+
+func matchFlagPattern(pattern, solutionAttempt string) bool {
+	compiled, ok := flagPatterns.Load(pattern)
+	if !ok {
+		// the whole answer has to match the pattern
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return false
+		}
+		compiled, _ = flagPatterns.LoadOrStore(pattern, re)
+	}
+
+	return compiled.(*regexp.Regexp).MatchString(solutionAttempt)
+}
+
+// GetTeamChallengeSolutionAttemptTimes returns the times of the team attempts on the challenge from the latest one
+func (s *EventService) GetTeamChallengeSolutionAttemptTimes(ctx context.Context, eventID, challengeID, teamID uuid.UUID) ([]time.Time, error) {
+	return s.repository.GetTeamChallengeSolutionAttemptTimes(ctx, postgres.GetTeamChallengeSolutionAttemptTimesParams{
+		EventID:     eventID,
+		ChallengeID: challengeID,
+		TeamID:      teamID,
+	})
+}
+
+func (s *EventService) UpdateEventChallengeReleaseTime(ctx context.Context, eventID, challengeID uuid.UUID, releaseTime *time.Time) error {
+	if err := s.repository.UpdateEventChallengeReleaseTime(ctx, postgres.UpdateEventChallengeReleaseTimeParams{
+		ID:          challengeID,
+		EventID:     eventID,
+		ReleaseTime: toNullTime(releaseTime),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *EventService) UpdateEventChallengeVisibility(ctx context.Context, eventID, challengeID uuid.UUID, visibility int32) error {
+	if err := s.repository.UpdateEventChallengeVisibility(ctx, postgres.UpdateEventChallengeVisibilityParams{
+		ID:         challengeID,
+		EventID:    eventID,
+		Visibility: visibility,
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
+
+func (s *EventService) UpdateEventChallengeScoring(ctx context.Context, challenge *model.Challenge) error {
+	if err := s.repository.UpdateEventChallengeScoring(ctx, postgres.UpdateEventChallengeScoringParams{
+		ID:                    challenge.ID,
+		EventID:               challenge.EventID,
+		DynamicMax:            toNullInt32(challenge.DynamicMaxScore),
+		DynamicMin:            toNullInt32(challenge.DynamicMinScore),
+		DynamicSolveThreshold: toNullInt32(challenge.DynamicSolveThreshold),
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(challenge.EventID)
+
+	return nil
+}
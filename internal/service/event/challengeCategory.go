@@ -13,6 +13,7 @@ type (
 		GetEventChallengeCategories(ctx context.Context, eventID uuid.UUID) ([]postgres.EventChallengeCategory, error)
 		UpdateEventChallengeCategory(ctx context.Context, arg postgres.UpdateEventChallengeCategoryParams) error
 		UpdateEventChallengeCategoryOrder(ctx context.Context, arg postgres.UpdateEventChallengeCategoryOrderParams) error
+		UpdateEventChallengeCategoryVisibility(ctx context.Context, arg postgres.UpdateEventChallengeCategoryVisibilityParams) error
 		DeleteEventChallengeCategory(ctx context.Context, arg postgres.DeleteEventChallengeCategoryParams) error
 
 		WithTransaction(ctx context.Context) (withTx interface{}, commit func(), rollback func(), err error)
@@ -27,12 +28,19 @@ func (s *EventService) GetEventCategories(ctx context.Context, eventID uuid.UUID
 
 	result := make([]*model.ChallengeCategory, 0, len(categories))
 	for _, category := range categories {
-		result = append(result, &model.ChallengeCategory{
-			ID:      category.ID,
-			Name:    category.Name,
-			Order:   category.OrderIndex,
-			EventID: category.EventID,
-		})
+		c := &model.ChallengeCategory{
+			ID:         category.ID,
+			Name:       category.Name,
+			Order:      category.OrderIndex,
+			EventID:    category.EventID,
+			Visibility: category.Visibility,
+		}
+
+		if category.ReleaseTime.Valid {
+			c.ReleaseTime = &category.ReleaseTime.Time
+		}
+
+		result = append(result, c)
 	}
 
 	return result, nil
@@ -40,10 +48,11 @@ func (s *EventService) GetEventCategories(ctx context.Context, eventID uuid.UUID
 
 func (s *EventService) CreateEventCategory(ctx context.Context, category *model.ChallengeCategory) error {
 	if err := s.repository.CreateEventChallengeCategory(ctx, postgres.CreateEventChallengeCategoryParams{
-		ID:         uuid.Must(uuid.NewV7()),
-		EventID:    category.EventID,
-		Name:       category.Name,
-		OrderIndex: category.Order,
+		ID:          uuid.Must(uuid.NewV7()),
+		EventID:     category.EventID,
+		Name:        category.Name,
+		OrderIndex:  category.Order,
+		ReleaseTime: toNullTime(category.ReleaseTime),
 	}); err != nil {
 		return err
 	}
@@ -53,9 +62,10 @@ func (s *EventService) CreateEventCategory(ctx context.Context, category *model.
 
 func (s *EventService) UpdateEventCategory(ctx context.Context, category *model.ChallengeCategory) error {
 	if err := s.repository.UpdateEventChallengeCategory(ctx, postgres.UpdateEventChallengeCategoryParams{
-		EventID: category.EventID,
-		ID:      category.ID,
-		Name:    category.Name,
+		EventID:     category.EventID,
+		ID:          category.ID,
+		Name:        category.Name,
+		ReleaseTime: toNullTime(category.ReleaseTime),
 	}); err != nil {
 		return err
 	}
@@ -63,6 +73,20 @@ func (s *EventService) UpdateEventCategory(ctx context.Context, category *model.
 	return nil
 }
 
+func (s *EventService) UpdateEventCategoryVisibility(ctx context.Context, eventID, categoryID uuid.UUID, visibility int32) error {
+	if err := s.repository.UpdateEventChallengeCategoryVisibility(ctx, postgres.UpdateEventChallengeCategoryVisibilityParams{
+		ID:         categoryID,
+		EventID:    eventID,
+		Visibility: visibility,
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
+
 func (s *EventService) DeleteEventCategory(ctx context.Context, eventID uuid.UUID, categoryID uuid.UUID) error {
 	if err := s.repository.DeleteEventChallengeCategory(ctx, postgres.DeleteEventChallengeCategoryParams{
 		EventID: eventID,
@@ -71,6 +95,8 @@ func (s *EventService) DeleteEventCategory(ctx context.Context, eventID uuid.UUI
 		return err
 	}
 
+	s.scores.invalidate(eventID)
+
 	return nil
 }
 
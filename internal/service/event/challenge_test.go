@@ -0,0 +1,155 @@
+package event
+
+import (
+	"github.com/cybericebox/daemon/internal/model"
+	"testing"
+)
+
+func TestIsCorrectSolution(t *testing.T) {
+	tests := []struct {
+		name            string
+		task            *model.Task
+		flag            string
+		solutionAttempt string
+		want            bool
+	}{
+		{
+			name:            "random flag matches the team flag",
+			task:            &model.Task{FlagMatchMode: model.CaseInsensitiveFlagMatchMode},
+			flag:            "flag{Random}",
+			solutionAttempt: "flag{Random}",
+			want:            true,
+		},
+		{
+			name:            "random flag is matched exactly",
+			task:            &model.Task{FlagMatchMode: model.CaseInsensitiveFlagMatchMode},
+			flag:            "flag{Random}",
+			solutionAttempt: "flag{random}",
+			want:            false,
+		},
+		{
+			name:            "exact flag matches the team flag only",
+			task:            &model.Task{Flags: []string{"flag{one}", "flag{two}"}, FlagMatchMode: model.ExactFlagMatchMode},
+			flag:            "flag{one}",
+			solutionAttempt: "flag{two}",
+			want:            false,
+		},
+		{
+			name:            "case insensitive",
+			task:            &model.Task{Flags: []string{"flag{Case}"}, FlagMatchMode: model.CaseInsensitiveFlagMatchMode},
+			flag:            "flag{Case}",
+			solutionAttempt: "FLAG{case}",
+			want:            true,
+		},
+		{
+			name:            "trimmed",
+			task:            &model.Task{Flags: []string{"flag{trim}"}, FlagMatchMode: model.TrimmedFlagMatchMode},
+			flag:            "flag{trim}",
+			solutionAttempt: "  flag{trim}\n",
+			want:            true,
+		},
+		{
+			name:            "trimmed is case sensitive",
+			task:            &model.Task{Flags: []string{"flag{trim}"}, FlagMatchMode: model.TrimmedFlagMatchMode},
+			flag:            "flag{trim}",
+			solutionAttempt: " FLAG{trim} ",
+			want:            false,
+		},
+		{
+			name:            "any of the flags",
+			task:            &model.Task{Flags: []string{"flag{one}", "flag{two}"}, FlagMatchMode: model.AnyOfFlagMatchMode},
+			flag:            "flag{one}",
+			solutionAttempt: "flag{two}",
+			want:            true,
+		},
+		{
+			name:            "any of the flags rejects others",
+			task:            &model.Task{Flags: []string{"flag{one}", "flag{two}"}, FlagMatchMode: model.AnyOfFlagMatchMode},
+			flag:            "flag{one}",
+			solutionAttempt: "flag{three}",
+			want:            false,
+		},
+		{
+			name:            "regex matches",
+			task:            &model.Task{Flags: []string{`flag\{[0-9]+\}`}, FlagMatchMode: model.RegexFlagMatchMode},
+			flag:            `flag\{[0-9]+\}`,
+			solutionAttempt: "flag{123}",
+			want:            true,
+		},
+		{
+			name:            "regex matches any of the patterns",
+			task:            &model.Task{Flags: []string{`flag\{a+\}`, `flag\{b+\}`}, FlagMatchMode: model.RegexFlagMatchMode},
+			flag:            `flag\{a+\}`,
+			solutionAttempt: "flag{bbb}",
+			want:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isCorrectSolution(tt.task, tt.flag, tt.solutionAttempt); got != tt.want {
+				t.Errorf("isCorrectSolution() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchFlagPattern(t *testing.T) {
+	tests := []struct {
+		name            string
+		pattern         string
+		solutionAttempt string
+		want            bool
+	}{
+		{
+			name:            "whole answer matches",
+			pattern:         `flag\{[a-z]+\}`,
+			solutionAttempt: "flag{abc}",
+			want:            true,
+		},
+		{
+			name:            "prefix is not matched",
+			pattern:         `flag\{[a-z]+\}`,
+			solutionAttempt: "xflag{abc}",
+			want:            false,
+		},
+		{
+			name:            "suffix is not matched",
+			pattern:         `flag\{[a-z]+\}`,
+			solutionAttempt: "flag{abc}x",
+			want:            false,
+		},
+		{
+			name:            "alternation is anchored as a whole",
+			pattern:         `a|b`,
+			solutionAttempt: "ab",
+			want:            false,
+		},
+		{
+			name:            "alternation matches one of the branches",
+			pattern:         `a|b`,
+			solutionAttempt: "b",
+			want:            true,
+		},
+		{
+			name:            "invalid pattern matches nothing",
+			pattern:         `flag\{(`,
+			solutionAttempt: "flag{(",
+			want:            false,
+		},
+		{
+			name:            "compiled pattern is reused",
+			pattern:         `flag\{[a-z]+\}`,
+			solutionAttempt: "flag{123}",
+			want:            false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchFlagPattern(tt.pattern, tt.solutionAttempt); got != tt.want {
+				t.Errorf("matchFlagPattern(%q, %q) = %t, want %t", tt.pattern, tt.solutionAttempt, got, tt.want)
+			}
+		})
+	}
+}
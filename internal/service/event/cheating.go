@@ -0,0 +1,83 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	ICheatingRepository interface {
+		CreateEventCheatingIncident(ctx context.Context, arg postgres.CreateEventCheatingIncidentParams) error
+		GetEventCheatingIncidents(ctx context.Context, eventID uuid.UUID) ([]postgres.GetEventCheatingIncidentsRow, error)
+		EventTeamCheatingIncidentExists(ctx context.Context, arg postgres.EventTeamCheatingIncidentExistsParams) (bool, error)
+
+		UpdateEventTeamDisqualified(ctx context.Context, arg postgres.UpdateEventTeamDisqualifiedParams) error
+	}
+)
+
+func (s *EventService) GetEventCheatingIncidents(ctx context.Context, eventID uuid.UUID) ([]*model.CheatingIncident, error) {
+	incidents, err := s.repository.GetEventCheatingIncidents(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.CheatingIncident, 0, len(incidents))
+	for _, incident := range incidents {
+		result = append(result, &model.CheatingIncident{
+			ID:             incident.ID,
+			ChallengeID:    incident.ChallengeID,
+			ChallengeName:  incident.ChallengeName,
+			TeamID:         incident.TeamID,
+			TeamName:       incident.TeamName,
+			SourceTeamID:   incident.SourceTeamID,
+			SourceTeamName: incident.SourceTeamName,
+			ParticipantID:  incident.ParticipantID,
+			Answer:         incident.Answer,
+			CreatedAt:      incident.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *EventService) TeamHasCheatingIncident(ctx context.Context, eventID, challengeID, teamID uuid.UUID) (bool, error) {
+	return s.repository.EventTeamCheatingIncidentExists(ctx, postgres.EventTeamCheatingIncidentExistsParams{
+		EventID:     eventID,
+		ChallengeID: challengeID,
+		TeamID:      teamID,
+	})
+}
+
+func (s *EventService) UpdateTeamDisqualified(ctx context.Context, eventID, teamID uuid.UUID, disqualified bool) error {
+	if _, err := s.repository.GetEventTeamByID(ctx, postgres.GetEventTeamByIDParams{
+		ID:      teamID,
+		EventID: eventID,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ErrTeamNotFound
+		}
+		return err
+	}
+
+	currentUserID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repository.UpdateEventTeamDisqualified(ctx, postgres.UpdateEventTeamDisqualifiedParams{
+		ID:           teamID,
+		Disqualified: disqualified,
+		UpdatedBy:    uuid.NullUUID{UUID: currentUserID, Valid: true},
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	IHintRepository interface {
+		CreateEventChallengeHint(ctx context.Context, arg postgres.CreateEventChallengeHintParams) error
+		GetEventChallengesHints(ctx context.Context, eventID uuid.UUID) ([]postgres.EventChallengeHint, error)
+
+		CreateEventTeamHintUnlock(ctx context.Context, arg postgres.CreateEventTeamHintUnlockParams) error
+		GetEventTeamUnlockedHintIDs(ctx context.Context, arg postgres.GetEventTeamUnlockedHintIDsParams) ([]uuid.UUID, error)
+		GetEventHintUnlocks(ctx context.Context, eventID uuid.UUID) ([]postgres.GetEventHintUnlocksRow, error)
+	}
+)
+
+func (s *EventService) createEventChallengeHints(ctx context.Context, eventID, challengeID uuid.UUID, hints []model.Hint) error {
+	for index, hint := range hints {
+		if err := s.repository.CreateEventChallengeHint(ctx, postgres.CreateEventChallengeHintParams{
+			ID:          uuid.Must(uuid.NewV7()),
+			EventID:     eventID,
+			ChallengeID: challengeID,
+			OrderIndex:  int32(index + 1),
+			Content:     hint.Content,
+			Cost:        hint.Cost,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *EventService) GetEventChallengesHints(ctx context.Context, eventID uuid.UUID) ([]*model.ChallengeHint, error) {
+	hints, err := s.repository.GetEventChallengesHints(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.ChallengeHint, 0, len(hints))
+	for _, hint := range hints {
+		result = append(result, &model.ChallengeHint{
+			ID:          hint.ID,
+			ChallengeID: hint.ChallengeID,
+			Order:       hint.OrderIndex,
+			Content:     hint.Content,
+			Cost:        hint.Cost,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *EventService) GetTeamUnlockedHintIDs(ctx context.Context, eventID, teamID uuid.UUID) ([]uuid.UUID, error) {
+	return s.repository.GetEventTeamUnlockedHintIDs(ctx, postgres.GetEventTeamUnlockedHintIDsParams{
+		EventID: eventID,
+		TeamID:  teamID,
+	})
+}
+
+func (s *EventService) UnlockHint(ctx context.Context, eventID, teamID uuid.UUID, hint *model.ChallengeHint) error {
+	currentUserID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the cost is stored with the unlock, so later changes of the hint do not affect the score
+	if err = s.repository.CreateEventTeamHintUnlock(ctx, postgres.CreateEventTeamHintUnlockParams{
+		EventID:     eventID,
+		TeamID:      teamID,
+		ChallengeID: hint.ChallengeID,
+		HintID:      hint.ID,
+		Cost:        hint.Cost,
+		UnlockedBy:  uuid.NullUUID{UUID: currentUserID, Valid: true},
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
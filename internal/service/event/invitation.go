@@ -0,0 +1,202 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	IInvitationRepository interface {
+		CreateEventInvitationCode(ctx context.Context, arg postgres.CreateEventInvitationCodeParams) error
+		GetEventInvitationCodes(ctx context.Context, eventID uuid.UUID) ([]postgres.EventInvitationCode, error)
+		DeleteEventInvitationCode(ctx context.Context, arg postgres.DeleteEventInvitationCodeParams) error
+		UseEventInvitationCode(ctx context.Context, arg postgres.UseEventInvitationCodeParams) (uuid.UUID, error)
+		EventInvitationCodeValid(ctx context.Context, arg postgres.EventInvitationCodeValidParams) (bool, error)
+		ReleaseEventInvitationCodeUse(ctx context.Context, id uuid.UUID) error
+
+		CreateEventInvitation(ctx context.Context, arg postgres.CreateEventInvitationParams) error
+		GetEventInvitations(ctx context.Context, eventID uuid.UUID) ([]postgres.GetEventInvitationsRow, error)
+		DeleteEventInvitation(ctx context.Context, arg postgres.DeleteEventInvitationParams) error
+		EventInvitationExists(ctx context.Context, arg postgres.EventInvitationExistsParams) (bool, error)
+		GetUserInvitedEventIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	}
+)
+
+func (s *EventService) GetEventInvitationCodes(ctx context.Context, eventID uuid.UUID) ([]*model.InvitationCode, error) {
+	codes, err := s.repository.GetEventInvitationCodes(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.InvitationCode, 0, len(codes))
+	for _, code := range codes {
+		c := &model.InvitationCode{
+			ID:        code.ID,
+			EventID:   code.EventID,
+			Code:      code.Code,
+			MaxUses:   code.MaxUses,
+			Uses:      code.Uses,
+			CreatedBy: code.CreatedBy,
+			CreatedAt: code.CreatedAt,
+		}
+
+		if code.ExpiresAt.Valid {
+			c.ExpiresAt = &code.ExpiresAt.Time
+		}
+
+		result = append(result, c)
+	}
+
+	return result, nil
+}
+
+func (s *EventService) CreateEventInvitationCode(ctx context.Context, code *model.InvitationCode) (*model.InvitationCode, error) {
+	// get current user id
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	code.ID = uuid.Must(uuid.NewV7())
+	code.Code = uuid.Must(uuid.NewV4()).String()
+	code.CreatedBy = uuid.NullUUID{
+		UUID:  userID,
+		Valid: true,
+	}
+
+	expiresAt := sql.NullTime{}
+	if code.ExpiresAt != nil {
+		expiresAt = sql.NullTime{
+			Time:  *code.ExpiresAt,
+			Valid: true,
+		}
+	}
+
+	if err = s.repository.CreateEventInvitationCode(ctx, postgres.CreateEventInvitationCodeParams{
+		ID:        code.ID,
+		EventID:   code.EventID,
+		Code:      code.Code,
+		MaxUses:   code.MaxUses,
+		ExpiresAt: expiresAt,
+		CreatedBy: code.CreatedBy,
+	}); err != nil {
+		return nil, err
+	}
+
+	return code, nil
+}
+
+func (s *EventService) DeleteEventInvitationCode(ctx context.Context, eventID, codeID uuid.UUID) error {
+	if err := s.repository.DeleteEventInvitationCode(ctx, postgres.DeleteEventInvitationCodeParams{
+		ID:      codeID,
+		EventID: eventID,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UseEventInvitationCode counts the use of the code, if the code is not expired and not used up, the code id is returned
+func (s *EventService) UseEventInvitationCode(ctx context.Context, eventID uuid.UUID, code string) (uuid.UUID, error) {
+	codeID, err := s.repository.UseEventInvitationCode(ctx, postgres.UseEventInvitationCodeParams{
+		EventID: eventID,
+		Code:    code,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, model.ErrInvitationCodeInvalid
+		}
+		return uuid.Nil, err
+	}
+	return codeID, nil
+}
+
+// CheckEventInvitationCode checks, if the code is not expired and not used up, without counting the use
+func (s *EventService) CheckEventInvitationCode(ctx context.Context, eventID uuid.UUID, code string) error {
+	valid, err := s.repository.EventInvitationCodeValid(ctx, postgres.EventInvitationCodeValidParams{
+		EventID: eventID,
+		Code:    code,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		return model.ErrInvitationCodeInvalid
+	}
+	return nil
+}
+
+// ReleaseEventInvitationCodeUse returns the use to the code, if the user did not join the event with it
+func (s *EventService) ReleaseEventInvitationCodeUse(ctx context.Context, codeID uuid.UUID) error {
+	return s.repository.ReleaseEventInvitationCodeUse(ctx, codeID)
+}
+
+func (s *EventService) GetEventInvitations(ctx context.Context, eventID uuid.UUID) ([]*model.Invitation, error) {
+	invitations, err := s.repository.GetEventInvitations(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, &model.Invitation{
+			UserID:    invitation.UserID,
+			Name:      invitation.Name,
+			Email:     invitation.Email,
+			CreatedBy: invitation.CreatedBy,
+			CreatedAt: invitation.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *EventService) CreateEventInvitations(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error {
+	// get current user id
+	currentUserID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if err = s.repository.CreateEventInvitation(ctx, postgres.CreateEventInvitationParams{
+			EventID: eventID,
+			UserID:  userID,
+			CreatedBy: uuid.NullUUID{
+				UUID:  currentUserID,
+				Valid: true,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *EventService) DeleteEventInvitation(ctx context.Context, eventID, userID uuid.UUID) error {
+	if err := s.repository.DeleteEventInvitation(ctx, postgres.DeleteEventInvitationParams{
+		EventID: eventID,
+		UserID:  userID,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *EventService) IsUserInvitedToEvent(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	return s.repository.EventInvitationExists(ctx, postgres.EventInvitationExistsParams{
+		EventID: eventID,
+		UserID:  userID,
+	})
+}
+
+func (s *EventService) GetUserInvitedEventIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return s.repository.GetUserInvitedEventIDs(ctx, userID)
+}
@@ -13,6 +13,7 @@ type (
 	IJoinRepository interface {
 		GetEventJoinStatus(ctx context.Context, arg postgres.GetEventJoinStatusParams) (int32, error)
 		CreateEventParticipant(ctx context.Context, arg postgres.CreateEventParticipantParams) error
+		DeleteEventParticipant(ctx context.Context, arg postgres.DeleteEventParticipantParams) error
 	}
 )
 
@@ -42,3 +43,11 @@ func (s *EventService) CreateJoinEventRequest(ctx context.Context, eventID, user
 	}
 	return nil
 }
+
+// DeleteJoinEventRequest deletes the join request of the user, who failed to join the event
+func (s *EventService) DeleteJoinEventRequest(ctx context.Context, eventID, userID uuid.UUID) error {
+	return s.repository.DeleteEventParticipant(ctx, postgres.DeleteEventParticipantParams{
+		EventID: eventID,
+		UserID:  userID,
+	})
+}
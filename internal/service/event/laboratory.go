@@ -0,0 +1,71 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-multierror"
+)
+
+type (
+	ILaboratoryRepository interface {
+		DeleteLabs(ctx context.Context, labIDs ...uuid.UUID) error
+		DeleteEventTeamsLaboratories(ctx context.Context, eventID uuid.UUID) error
+		DeleteEventTeamsLabChallenges(ctx context.Context, eventID uuid.UUID) error
+
+		GetEventParticipantsUserIDs(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error)
+		DeleteClient(ctx context.Context, clientID string) error
+	}
+)
+
+// ReleaseEventResources deletes the laboratories of all event teams and the vpn clients of all event participants
+func (s *EventService) ReleaseEventResources(ctx context.Context, eventID uuid.UUID) error {
+	// get all teams in event
+	teams, err := s.repository.GetEventTeams(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	labIDs := make([]uuid.UUID, 0, len(teams))
+	for _, team := range teams {
+		if team.LaboratoryID.Valid {
+			labIDs = append(labIDs, team.LaboratoryID.UUID)
+		}
+	}
+
+	userIDs, err := s.repository.GetEventParticipantsUserIDs(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+
+	for _, userID := range userIDs {
+		if err = s.repository.DeleteClient(ctx, fmt.Sprintf("%s-%s", eventID.String(), userID.String())); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	// the vpn clients are deleted even if no team has a laboratory, because the laboratories can be already released
+	if len(labIDs) == 0 {
+		return errs
+	}
+
+	if err = s.repository.DeleteLabs(ctx, labIDs...); err != nil {
+		return multierror.Append(errs, err)
+	}
+
+	if err = s.repository.DeleteEventTeamsLaboratories(ctx, eventID); err != nil {
+		return multierror.Append(errs, err)
+	}
+
+	if err = s.repository.DeleteEventTeamsLabChallenges(ctx, eventID); err != nil {
+		return multierror.Append(errs, err)
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	return nil
+}
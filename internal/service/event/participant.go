@@ -2,14 +2,85 @@ package event
 
 import (
 	"context"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
 )
 
 type (
 	IParticipantRepository interface {
 		GetVPNClientConfig(ctx context.Context, clientID, destCIDR string) (string, error)
+
+		GetEventParticipants(ctx context.Context, eventID uuid.UUID) ([]postgres.GetEventParticipantsRow, error)
+		UpdateEventParticipantStatus(ctx context.Context, arg postgres.UpdateEventParticipantStatusParams) error
+
+		CountEventActiveParticipants(ctx context.Context, eventID uuid.UUID) (int64, error)
+		GetEventWaitlistedParticipants(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error)
 	}
 )
 
 func (s *EventService) GetParticipantVPNConfig(ctx context.Context, participantID, labCIDR string) (string, error) {
 	return s.repository.GetVPNClientConfig(ctx, participantID, labCIDR)
 }
+
+func (s *EventService) GetEventParticipants(ctx context.Context, eventID uuid.UUID) ([]*model.Participant, error) {
+	participants, err := s.repository.GetEventParticipants(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Participant, 0, len(participants))
+	for _, participant := range participants {
+		p := &model.Participant{
+			UserID:         participant.UserID,
+			Name:           participant.Name,
+			Email:          participant.Email,
+			TeamID:         participant.TeamID,
+			TeamName:       participant.TeamName.String,
+			ApprovalStatus: participant.ApprovalStatus,
+			UpdatedBy:      participant.UpdatedBy,
+			CreatedAt:      participant.CreatedAt,
+		}
+
+		if participant.UpdatedAt.Valid {
+			p.UpdatedAt = &participant.UpdatedAt.Time
+		}
+
+		result = append(result, p)
+	}
+
+	return result, nil
+}
+
+// UpdateParticipantStatus sets the approval status of the participant, the current user is recorded as the approver
+func (s *EventService) UpdateParticipantStatus(ctx context.Context, eventID, userID uuid.UUID, status int32) error {
+	// get current user id
+	currentUserID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repository.UpdateEventParticipantStatus(ctx, postgres.UpdateEventParticipantStatusParams{
+		EventID:        eventID,
+		UserID:         userID,
+		ApprovalStatus: status,
+		UpdatedBy: uuid.NullUUID{
+			UUID:  currentUserID,
+			Valid: true,
+		},
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CountEventActiveParticipants returns the count of the pending and approved participants, which take the event places
+func (s *EventService) CountEventActiveParticipants(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	return s.repository.CountEventActiveParticipants(ctx, eventID)
+}
+
+// GetEventWaitlistedParticipants returns the user ids of the waitlisted participants in order of the registration
+func (s *EventService) GetEventWaitlistedParticipants(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error) {
+	return s.repository.GetEventWaitlistedParticipants(ctx, eventID)
+}
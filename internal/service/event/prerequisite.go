@@ -0,0 +1,93 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	IPrerequisiteRepository interface {
+		CreateEventChallengePrerequisite(ctx context.Context, arg postgres.CreateEventChallengePrerequisiteParams) error
+		CreateEventChallengePrerequisiteChallenge(ctx context.Context, arg postgres.CreateEventChallengePrerequisiteChallengeParams) error
+		GetEventChallengesPrerequisites(ctx context.Context, eventID uuid.UUID) ([]postgres.EventChallengePrerequisite, error)
+		GetEventChallengesPrerequisiteChallenges(ctx context.Context, eventID uuid.UUID) ([]postgres.EventChallengePrerequisiteChallenge, error)
+		DeleteEventChallengePrerequisites(ctx context.Context, arg postgres.DeleteEventChallengePrerequisitesParams) error
+
+		GetTeamSolvedChallengeIDsInEvent(ctx context.Context, arg postgres.GetTeamSolvedChallengeIDsInEventParams) ([]uuid.UUID, error)
+	}
+)
+
+func (s *EventService) GetEventChallengesPrerequisites(ctx context.Context, eventID uuid.UUID) ([]*model.ChallengePrerequisite, error) {
+	prerequisites, err := s.repository.GetEventChallengesPrerequisites(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	prerequisiteChallenges, err := s.repository.GetEventChallengesPrerequisiteChallenges(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	challengeIDs := make(map[uuid.UUID][]uuid.UUID)
+	for _, challenge := range prerequisiteChallenges {
+		challengeIDs[challenge.PrerequisiteID] = append(challengeIDs[challenge.PrerequisiteID], challenge.ChallengeID)
+	}
+
+	result := make([]*model.ChallengePrerequisite, 0, len(prerequisites))
+	for _, prerequisite := range prerequisites {
+		result = append(result, &model.ChallengePrerequisite{
+			ID:            prerequisite.ID,
+			ChallengeID:   prerequisite.ChallengeID,
+			Type:          prerequisite.Type,
+			ChallengeIDs:  challengeIDs[prerequisite.ID],
+			RequiredCount: prerequisite.RequiredCount,
+			RequiredScore: prerequisite.RequiredScore,
+		})
+	}
+
+	return result, nil
+}
+
+// UpdateEventChallengePrerequisites replaces all prerequisites of the challenge
+func (s *EventService) UpdateEventChallengePrerequisites(ctx context.Context, eventID, challengeID uuid.UUID, prerequisites []*model.ChallengePrerequisite) error {
+	if err := s.repository.DeleteEventChallengePrerequisites(ctx, postgres.DeleteEventChallengePrerequisitesParams{
+		EventID:     eventID,
+		ChallengeID: challengeID,
+	}); err != nil {
+		return err
+	}
+
+	for _, prerequisite := range prerequisites {
+		prerequisiteID := uuid.Must(uuid.NewV7())
+		if err := s.repository.CreateEventChallengePrerequisite(ctx, postgres.CreateEventChallengePrerequisiteParams{
+			ID:            prerequisiteID,
+			EventID:       eventID,
+			ChallengeID:   challengeID,
+			Type:          prerequisite.Type,
+			RequiredCount: prerequisite.RequiredCount,
+			RequiredScore: prerequisite.RequiredScore,
+		}); err != nil {
+			return err
+		}
+
+		for _, id := range prerequisite.ChallengeIDs {
+			if err := s.repository.CreateEventChallengePrerequisiteChallenge(ctx, postgres.CreateEventChallengePrerequisiteChallengeParams{
+				PrerequisiteID: prerequisiteID,
+				ChallengeID:    id,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *EventService) GetTeamSolvedChallengeIDs(ctx context.Context, eventID, teamID uuid.UUID) ([]uuid.UUID, error) {
+	return s.repository.GetTeamSolvedChallengeIDsInEvent(ctx, postgres.GetTeamSolvedChallengeIDsInEventParams{
+		EventID: eventID,
+		TeamID:  teamID,
+	})
+}
@@ -0,0 +1,67 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+// getProvisionedChallenges filters the challenges, which exercises have to be deployed now,
+// the exercise is deployed shortly before the release of its first challenge, so its instances are ready in time
+func (s *EventService) getProvisionedChallenges(ctx context.Context, eventID uuid.UUID, challenges []postgres.EventChallenge) ([]postgres.EventChallenge, error) {
+	categories, err := s.repository.GetEventChallengeCategories(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoriesReleaseTime := make(map[uuid.UUID]sql.NullTime, len(categories))
+	for _, category := range categories {
+		categoriesReleaseTime[category.ID] = category.ReleaseTime
+	}
+
+	provisionTime := time.Now().UTC().Add(config.ChallengeProvisionLeadTime)
+
+	provisionedExercises := make(map[uuid.UUID]bool)
+	for _, challenge := range challenges {
+		releaseTime := challenge.ReleaseTime
+		if categoryReleaseTime := categoriesReleaseTime[challenge.CategoryID]; categoryReleaseTime.Valid &&
+			(!releaseTime.Valid || categoryReleaseTime.Time.After(releaseTime.Time)) {
+			releaseTime = categoryReleaseTime
+		}
+
+		if !releaseTime.Valid || !releaseTime.Time.After(provisionTime) {
+			provisionedExercises[challenge.ExerciseID] = true
+		}
+	}
+
+	result := make([]postgres.EventChallenge, 0, len(challenges))
+	for _, challenge := range challenges {
+		if provisionedExercises[challenge.ExerciseID] {
+			result = append(result, challenge)
+		}
+	}
+
+	return result, nil
+}
+
+func fromNullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{
+		Time:  *t,
+		Valid: true,
+	}
+}
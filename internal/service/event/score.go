@@ -2,10 +2,12 @@ package event
 
 import (
 	"context"
+	"database/sql"
 	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gofrs/uuid"
+	"slices"
 	"sort"
 	"time"
 )
@@ -13,59 +15,162 @@ import (
 type (
 	IScoreRepository interface {
 		GetAllChallengesSolutionsInEvent(ctx context.Context, eventID uuid.UUID) ([]postgres.GetAllChallengesSolutionsInEventRow, error)
+
+		UpdateEventScoreboardRevealed(ctx context.Context, arg postgres.UpdateEventScoreboardRevealedParams) error
 	}
 )
 
 func (s *EventService) GetScore(ctx context.Context, eventID uuid.UUID) (*model.EventScore, error) {
-	event, err := s.repository.GetEventByID(ctx, eventID)
-	if err != nil {
+	var score *model.EventScore
+	if err := s.withScoreState(ctx, eventID, func(state *eventScoreState) {
+		// the score is calculated once after the state is changed and shared by the requests until the next change
+		if state.score == nil {
+			state.score = calculateScore(state)
+		}
+		score = state.score
+	}); err != nil {
 		return nil, err
 	}
 
-	teams, err := s.repository.GetEventTeams(ctx, eventID)
-	if err != nil {
+	return score, nil
+}
+
+// GetFrozenScore returns the standings at the scoreboard freeze time,
+// the changes of the given team after the freeze are included, so the participants still see their own solves
+func (s *EventService) GetFrozenScore(ctx context.Context, eventID, teamID uuid.UUID) (*model.EventScore, error) {
+	var score *model.EventScore
+	if err := s.withScoreState(ctx, eventID, func(state *eventScoreState) {
+		// the frozen score differs only by the viewer team, so it is cached per team
+		if state.frozenScores == nil {
+			state.frozenScores = make(map[uuid.UUID]*model.EventScore)
+		}
+		if state.frozenScores[teamID] == nil {
+			state.frozenScores[teamID] = calculateScore(frozenScoreState(state, teamID))
+		}
+		score = state.frozenScores[teamID]
+	}); err != nil {
 		return nil, err
 	}
 
-	challenges, err := s.repository.GetEventChallenges(ctx, eventID)
-	if err != nil {
+	return score, nil
+}
+
+// GetScoreAt returns the standings at the given time, only the changes made until that time are evaluated,
+// so the dynamic points of the challenges are the same as they were at that time, except the changes of the given team
+func (s *EventService) GetScoreAt(ctx context.Context, eventID uuid.UUID, at time.Time, teamID uuid.UUID) (*model.EventScore, error) {
+	var score *model.EventScore
+	if err := s.withScoreState(ctx, eventID, func(state *eventScoreState) {
+		// the replay scores are not cached, because they are requested for arbitrary times
+		score = calculateScore(scoreStateAt(state, at, teamID))
+	}); err != nil {
 		return nil, err
 	}
 
-	solutionsByChallenges, err := s.getSolutionsByChallenges(ctx, eventID)
-	if err != nil {
-		return nil, err
+	return score, nil
+}
+
+func (s *EventService) UpdateEventScoreboardRevealed(ctx context.Context, eventID uuid.UUID, revealed bool) error {
+	if err := s.repository.UpdateEventScoreboardRevealed(ctx, postgres.UpdateEventScoreboardRevealedParams{
+		ID:                 eventID,
+		ScoreboardRevealed: revealed,
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
+
+// frozenScoreState returns the copy of the state without the changes made after the scoreboard freeze,
+// except the changes of the given team
+func frozenScoreState(state *eventScoreState, teamID uuid.UUID) *eventScoreState {
+	if !state.event.ScoreboardFreezeTime.Valid {
+		return state
+	}
+
+	return scoreStateAt(state, state.event.ScoreboardFreezeTime.Time, teamID)
+}
+
+// scoreStateAt returns the copy of the state without the changes made after the given time,
+// except the changes of the given team
+func scoreStateAt(state *eventScoreState, at time.Time, teamID uuid.UUID) *eventScoreState {
+	result := *state
+	result.score = nil
+	result.frozenScores = nil
+
+	// the teams created after the given time were not on the scoreboard yet
+	result.teams = nil
+	for _, team := range state.teams {
+		if team.ID == teamID || !team.CreatedAt.After(at) {
+			result.teams = append(result.teams, team)
+		}
+	}
+
+	result.solutionsByChallenges = make(map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow)
+	for challengeID, solutions := range state.solutionsByChallenges {
+		for _, solution := range solutions {
+			if solution.TeamID == teamID || !solution.Timestamp.After(at) {
+				result.solutionsByChallenges[challengeID] = append(result.solutionsByChallenges[challengeID], solution)
+			}
+		}
+	}
+
+	result.hintUnlocks = nil
+	for _, unlock := range state.hintUnlocks {
+		if unlock.TeamID == teamID || !unlock.CreatedAt.After(at) {
+			result.hintUnlocks = append(result.hintUnlocks, unlock)
+		}
 	}
 
-	challengePoints := make(map[uuid.UUID]int32)
-	if !event.DynamicScoring {
-		for _, challenge := range challenges {
-			challengePoints[challenge.ID] = challenge.Points
+	result.adjustments = nil
+	for _, adjustment := range state.adjustments {
+		if adjustment.TeamID == teamID || !adjustment.CreatedAt.After(at) {
+			result.adjustments = append(result.adjustments, adjustment)
 		}
 	}
+
+	return &result
+}
+
+func calculateScore(state *eventScoreState) *model.EventScore {
+	// the solutions of the disqualified teams do not take the solve order bonuses and do not decay the points
+	solutionsByChallenges := qualifiedSolutions(state)
+	challengesPoints := calculateChallengesPoints(state.event, state.challenges, solutionsByChallenges)
+
 	var teamScores []model.TeamScore
-	for _, team := range teams {
+	for _, team := range state.teams {
+		// disqualified teams are removed from the scoreboard
+		if team.Disqualified {
+			continue
+		}
+
 		teamSolutions := make(map[uuid.UUID]model.TeamSolution)
 
-		var solvesForTimeline []model.SolutionForTimeline
+		var timeline []model.TimelineEvent
 		score := 0
 	GlobalLoop:
 		for challengeID, solutions := range solutionsByChallenges {
-			challengeSolutionCount := len(solutions)
+			if state.archivedChallenges[challengeID] {
+				continue
+			}
+
 			for index, solution := range solutions {
 				if solution.TeamID == team.ID {
+					bonus := solveOrderBonus(state.event, index+1)
 					teamSolutions[challengeID] = model.TeamSolution{
-						ID:   solution.ChallengeID,
-						Rank: index + 1,
-					}
-					points := challengePoints[challengeID]
-					if event.DynamicScoring {
-						points = tools.CalculateScore(event.DynamicMin, event.DynamicMax, event.DynamicSolveThreshold, float64(challengeSolutionCount))
+						ID:       solution.ChallengeID,
+						Rank:     index + 1,
+						Bonus:    int(bonus),
+						SolvedAt: solution.Timestamp,
 					}
+					points := challengesPoints[challengeID] + bonus
 					score += int(points)
-					solvesForTimeline = append(solvesForTimeline, model.SolutionForTimeline{
-						Date:   solution.Timestamp,
-						Points: int(points),
+					timeline = append(timeline, model.TimelineEvent{
+						Type:        model.SolveTimelineEventType,
+						Time:        solution.Timestamp,
+						Points:      int(points),
+						ChallengeID: challengeID,
 					})
 
 					continue GlobalLoop
@@ -73,16 +178,47 @@ func (s *EventService) GetScore(ctx context.Context, eventID uuid.UUID) (*model.
 			}
 		}
 
-		teamScoreTimeline := convertToScoreTimeline(solvesForTimeline, event.StartTime)
+		// the latest solution is taken before the hint unlocks are added to the timeline
+		latestSolution := state.event.StartTime
+		for _, solve := range timeline {
+			if solve.Time.After(latestSolution) {
+				latestSolution = solve.Time
+			}
+		}
+
+		// deduct the cost of the unlocked hints
+		for _, unlock := range state.hintUnlocks {
+			if unlock.TeamID == team.ID && unlock.Cost > 0 && !state.archivedChallenges[unlock.ChallengeID] {
+				score -= int(unlock.Cost)
+				timeline = append(timeline, model.TimelineEvent{
+					Type:        model.HintUnlockTimelineEventType,
+					Time:        unlock.CreatedAt,
+					Points:      -int(unlock.Cost),
+					ChallengeID: unlock.ChallengeID,
+				})
+			}
+		}
 
-		latestSolution := teamScoreTimeline[len(teamScoreTimeline)-1][0].(time.Time)
+		// add the awards and penalties given by the organizers
+		for _, adjustment := range state.adjustments {
+			if adjustment.TeamID == team.ID {
+				score += int(adjustment.Points)
+				timeline = append(timeline, model.TimelineEvent{
+					Type:   model.AdjustmentTimelineEventType,
+					Time:   adjustment.CreatedAt,
+					Points: int(adjustment.Points),
+					Reason: adjustment.Reason,
+				})
+			}
+		}
 
 		teamScores = append(teamScores, model.TeamScore{
+			TeamID:            team.ID,
 			TeamName:          team.Name,
 			Score:             score,
 			TeamSolutions:     teamSolutions,
 			LatestSolution:    latestSolution,
-			TeamScoreTimeline: teamScoreTimeline,
+			TeamScoreTimeline: convertToScoreTimeline(timeline, state.event.StartTime),
 		})
 	}
 	sortTeamScores(teamScores)
@@ -94,8 +230,128 @@ func (s *EventService) GetScore(ctx context.Context, eventID uuid.UUID) (*model.
 
 	return &model.EventScore{
 		TeamsScores:   teamScores,
-		ChallengeList: convertToChallengeList(challenges),
-	}, nil
+		ChallengeList: convertToChallengeList(state.event, state.challenges, state.archivedChallenges, challengesPoints, solutionsByChallenges),
+	}
+}
+
+// GetEventChallengesScore returns the points, the bonus for the next solve and the solve state of the team
+// for the event challenges, all of them are taken from one snapshot of the score state
+func (s *EventService) GetEventChallengesScore(ctx context.Context, eventID, teamID uuid.UUID) (map[uuid.UUID]model.ChallengeInfo, error) {
+	var result map[uuid.UUID]model.ChallengeInfo
+	if err := s.withScoreState(ctx, eventID, func(state *eventScoreState) {
+		result = calculateChallengesScore(state, teamID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetFrozenEventChallengesScore returns the challenges score calculated from the solutions made before the scoreboard freeze
+// and the solutions of the given team
+func (s *EventService) GetFrozenEventChallengesScore(ctx context.Context, eventID, teamID uuid.UUID) (map[uuid.UUID]model.ChallengeInfo, error) {
+	var result map[uuid.UUID]model.ChallengeInfo
+	if err := s.withScoreState(ctx, eventID, func(state *eventScoreState) {
+		result = calculateChallengesScore(frozenScoreState(state, teamID), teamID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func calculateChallengesScore(state *eventScoreState, teamID uuid.UUID) map[uuid.UUID]model.ChallengeInfo {
+	solutionsByChallenges := qualifiedSolutions(state)
+	challengesPoints := calculateChallengesPoints(state.event, state.challenges, solutionsByChallenges)
+
+	result := make(map[uuid.UUID]model.ChallengeInfo, len(state.challenges))
+	for _, challenge := range state.challenges {
+		solutions := solutionsByChallenges[challenge.ID]
+		solvedIndex := slices.IndexFunc(solutions, func(solution postgres.GetAllChallengesSolutionsInEventRow) bool {
+			return solution.TeamID == teamID
+		}) // -1 if not solved
+
+		result[challenge.ID] = model.ChallengeInfo{
+			ID:         challenge.ID,
+			Name:       challenge.Name,
+			Points:     challengesPoints[challenge.ID],
+			Bonus:      solveOrderBonus(state.event, len(solutions)+1),
+			Solved:     solvedIndex != -1,
+			FirstBlood: solvedIndex == 0,
+		}
+	}
+
+	return result
+}
+
+// qualifiedSolutions returns the solutions of the state without the solutions of the disqualified teams
+func qualifiedSolutions(state *eventScoreState) map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow {
+	disqualified := make(map[uuid.UUID]bool)
+	for _, team := range state.teams {
+		if team.Disqualified {
+			disqualified[team.ID] = true
+		}
+	}
+
+	if len(disqualified) == 0 {
+		return state.solutionsByChallenges
+	}
+
+	result := make(map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow, len(state.solutionsByChallenges))
+	for challengeID, solutions := range state.solutionsByChallenges {
+		for _, solution := range solutions {
+			if !disqualified[solution.TeamID] {
+				result[challengeID] = append(result[challengeID], solution)
+			}
+		}
+	}
+
+	return result
+}
+
+// calculateChallengesPoints calculates the points of the challenges by the scoring strategy of the event,
+// the dynamic scoring parameters of the challenge override the event ones
+func calculateChallengesPoints(event postgres.Event, challenges []postgres.EventChallenge, solutionsByChallenges map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow) map[uuid.UUID]int32 {
+	strategy := scoringStrategy(event.ScoringStrategy)
+
+	result := make(map[uuid.UUID]int32, len(challenges))
+	for _, challenge := range challenges {
+		if !event.DynamicScoring {
+			result[challenge.ID] = challenge.Points
+			continue
+		}
+
+		dMin, dMax, dSolveThreshold := event.DynamicMin, event.DynamicMax, event.DynamicSolveThreshold
+		if challenge.DynamicMin.Valid {
+			dMin = challenge.DynamicMin.Int32
+		}
+		if challenge.DynamicMax.Valid {
+			dMax = challenge.DynamicMax.Int32
+		}
+		if challenge.DynamicSolveThreshold.Valid {
+			dSolveThreshold = challenge.DynamicSolveThreshold.Int32
+		}
+
+		result[challenge.ID] = strategy.CalculateScore(dMin, dMax, dSolveThreshold, float64(len(solutionsByChallenges[challenge.ID])))
+	}
+
+	return result
+}
+
+// solveOrderBonus returns the extra points for the solution with the given rank
+func solveOrderBonus(event postgres.Event, rank int) int32 {
+	return tools.SolveOrderBonus(rank, event.FirstSolveBonus, event.SecondSolveBonus, event.ThirdSolveBonus)
+}
+
+func scoringStrategy(strategy int32) tools.ScoringStrategy {
+	switch strategy {
+	case model.LogarithmicScoringStrategy:
+		return tools.LogarithmicScoring{}
+	case model.LinearScoringStrategy:
+		return tools.LinearScoring{}
+	default:
+		return tools.QuadraticScoring{}
+	}
 }
 
 func (s *EventService) getSolutionsByChallenges(ctx context.Context, eventID uuid.UUID) (map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow, error) {
@@ -112,22 +368,24 @@ func (s *EventService) getSolutionsByChallenges(ctx context.Context, eventID uui
 	return result, nil
 }
 
-func convertToScoreTimeline(solvesForTimeline []model.SolutionForTimeline, startTime time.Time) [][]interface{} {
-	var teamScoreTimeline [][]interface{}
-	sortTimeline(solvesForTimeline)
-	scoreForTimeline := 0
-	var teamScoreTime []interface{}
-	teamScoreTime = append(teamScoreTime, startTime)
-	teamScoreTime = append(teamScoreTime, 0)
-	teamScoreTimeline = append(teamScoreTimeline, teamScoreTime)
-	for _, solveForTimeLine := range solvesForTimeline {
-		teamScoreTime = []interface{}{}
-		teamScoreTime = append(teamScoreTime, solveForTimeLine.Date)
-		scoreForTimeline += solveForTimeLine.Points
-		teamScoreTime = append(teamScoreTime, scoreForTimeline)
-		teamScoreTimeline = append(teamScoreTimeline, teamScoreTime)
+// convertToScoreTimeline sorts the changes of the team score by time and fills the score after each of them
+func convertToScoreTimeline(timeline []model.TimelineEvent, startTime time.Time) []model.TimelineEvent {
+	sortTimeline(timeline)
+
+	result := make([]model.TimelineEvent, 0, len(timeline)+1)
+	result = append(result, model.TimelineEvent{
+		Type: model.StartTimelineEventType,
+		Time: startTime,
+	})
+
+	score := 0
+	for _, event := range timeline {
+		score += event.Points
+		event.Score = score
+		result = append(result, event)
 	}
-	return teamScoreTimeline
+
+	return result
 }
 
 func sortTeamScores(teamsScore []model.TeamScore) {
@@ -144,19 +402,67 @@ func sortTeamScores(teamsScore []model.TeamScore) {
 	})
 }
 
-func sortTimeline(solvesForTimeline []model.SolutionForTimeline) {
-	sort.SliceStable(solvesForTimeline, func(p, q int) bool {
-		return solvesForTimeline[p].Date.Before(solvesForTimeline[q].Date)
+func sortTimeline(timeline []model.TimelineEvent) {
+	sort.SliceStable(timeline, func(p, q int) bool {
+		return timeline[p].Time.Before(timeline[q].Time)
 	})
 }
 
-func convertToChallengeList(challenges []postgres.EventChallenge) []model.ChallengeInfo {
+func (s *EventService) getArchivedChallenges(ctx context.Context, eventID uuid.UUID, challenges []postgres.EventChallenge) (map[uuid.UUID]bool, error) {
+	categories, err := s.repository.GetEventChallengeCategories(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedCategories := make(map[uuid.UUID]bool)
+	for _, category := range categories {
+		if category.Visibility == model.ArchivedChallengeVisibilityType {
+			archivedCategories[category.ID] = true
+		}
+	}
+
+	result := make(map[uuid.UUID]bool)
+	for _, challenge := range challenges {
+		if challenge.Visibility == model.ArchivedChallengeVisibilityType || archivedCategories[challenge.CategoryID] {
+			result[challenge.ID] = true
+		}
+	}
+
+	return result, nil
+}
+
+func convertToChallengeList(event postgres.Event, challenges []postgres.EventChallenge, archivedChallenges map[uuid.UUID]bool, challengesPoints map[uuid.UUID]int32, solutionsByChallenges map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow) []model.ChallengeInfo {
 	var result []model.ChallengeInfo
 	for _, challenge := range challenges {
+		if archivedChallenges[challenge.ID] {
+			continue
+		}
+
 		result = append(result, model.ChallengeInfo{
-			ID:   challenge.ID,
-			Name: challenge.Name,
+			ID:     challenge.ID,
+			Name:   challenge.Name,
+			Points: challengesPoints[challenge.ID],
+			Bonus:  solveOrderBonus(event, len(solutionsByChallenges[challenge.ID])+1),
 		})
 	}
 	return result
 }
+
+func toNullInt32(v *int32) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{
+		Int32: *v,
+		Valid: true,
+	}
+}
+
+func fromNullInt32(v sql.NullInt32) *int32 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Int32
+}
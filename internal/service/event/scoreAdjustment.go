@@ -0,0 +1,124 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	IScoreAdjustmentRepository interface {
+		CreateEventScoreAdjustment(ctx context.Context, arg postgres.CreateEventScoreAdjustmentParams) error
+		GetEventScoreAdjustments(ctx context.Context, eventID uuid.UUID) ([]postgres.EventScoreAdjustment, error)
+		UpdateEventScoreAdjustment(ctx context.Context, arg postgres.UpdateEventScoreAdjustmentParams) error
+		DeleteEventScoreAdjustment(ctx context.Context, arg postgres.DeleteEventScoreAdjustmentParams) error
+	}
+)
+
+func (s *EventService) GetEventScoreAdjustments(ctx context.Context, eventID uuid.UUID) ([]*model.ScoreAdjustment, error) {
+	adjustments, err := s.repository.GetEventScoreAdjustments(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.ScoreAdjustment, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		a := &model.ScoreAdjustment{
+			ID:        adjustment.ID,
+			EventID:   adjustment.EventID,
+			TeamID:    adjustment.TeamID,
+			Points:    adjustment.Points,
+			Reason:    adjustment.Reason,
+			UpdatedBy: adjustment.UpdatedBy,
+			CreatedBy: adjustment.CreatedBy,
+			CreatedAt: adjustment.CreatedAt,
+		}
+
+		if adjustment.UpdatedAt.Valid {
+			a.UpdatedAt = &adjustment.UpdatedAt.Time
+		}
+
+		result = append(result, a)
+	}
+
+	return result, nil
+}
+
+func (s *EventService) CreateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) (*model.ScoreAdjustment, error) {
+	if _, err := s.repository.GetEventTeamByID(ctx, postgres.GetEventTeamByIDParams{
+		ID:      adjustment.TeamID,
+		EventID: adjustment.EventID,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	currentUserID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustment.ID = uuid.Must(uuid.NewV7())
+	adjustment.CreatedBy = uuid.NullUUID{
+		UUID:  currentUserID,
+		Valid: true,
+	}
+
+	if err = s.repository.CreateEventScoreAdjustment(ctx, postgres.CreateEventScoreAdjustmentParams{
+		ID:        adjustment.ID,
+		EventID:   adjustment.EventID,
+		TeamID:    adjustment.TeamID,
+		Points:    adjustment.Points,
+		Reason:    adjustment.Reason,
+		CreatedBy: adjustment.CreatedBy,
+	}); err != nil {
+		return nil, err
+	}
+
+	s.scores.invalidate(adjustment.EventID)
+
+	return adjustment, nil
+}
+
+func (s *EventService) UpdateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) error {
+	currentUserID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repository.UpdateEventScoreAdjustment(ctx, postgres.UpdateEventScoreAdjustmentParams{
+		ID:      adjustment.ID,
+		EventID: adjustment.EventID,
+		Points:  adjustment.Points,
+		Reason:  adjustment.Reason,
+		UpdatedBy: uuid.NullUUID{
+			UUID:  currentUserID,
+			Valid: true,
+		},
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(adjustment.EventID)
+
+	return nil
+}
+
+func (s *EventService) DeleteEventScoreAdjustment(ctx context.Context, eventID, adjustmentID uuid.UUID) error {
+	if err := s.repository.DeleteEventScoreAdjustment(ctx, postgres.DeleteEventScoreAdjustmentParams{
+		ID:      adjustmentID,
+		EventID: eventID,
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
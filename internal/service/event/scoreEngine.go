@@ -0,0 +1,191 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+type (
+	// scoreEngine keeps the data the event scores are calculated from in memory, so the scoreboard is not rebuilt
+	// from the database on each request, the correct solutions are added to the state of the event
+	// and the other changes invalidate it, so it is loaded again on the next request
+	scoreEngine struct {
+		m           sync.Mutex
+		events      map[uuid.UUID]*eventScoreState
+		generations map[uuid.UUID]uint64 // changed on each event change, to not cache the state loaded before the change
+		subscribers map[uuid.UUID]map[chan struct{}]bool
+	}
+
+	eventScoreState struct {
+		event                 postgres.Event
+		teams                 []postgres.GetEventTeamsRow
+		challenges            []postgres.EventChallenge
+		archivedChallenges    map[uuid.UUID]bool
+		solutionsByChallenges map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow
+		hintUnlocks           []postgres.GetEventHintUnlocksRow
+		adjustments           []postgres.EventScoreAdjustment
+
+		score        *model.EventScore               // calculated score, nil after the state is changed
+		frozenScores map[uuid.UUID]*model.EventScore // calculated frozen scores by the viewer team, nil after the state is changed
+	}
+)
+
+func newScoreEngine() *scoreEngine {
+	return &scoreEngine{
+		events:      make(map[uuid.UUID]*eventScoreState),
+		generations: make(map[uuid.UUID]uint64),
+		subscribers: make(map[uuid.UUID]map[chan struct{}]bool),
+	}
+}
+
+// withScoreState calls the function with the score state of the event under the engine lock,
+// the state is loaded from the database if it is not in memory
+func (s *EventService) withScoreState(ctx context.Context, eventID uuid.UUID, fn func(state *eventScoreState)) error {
+	s.scores.m.Lock()
+	if state, ok := s.scores.events[eventID]; ok {
+		fn(state)
+		s.scores.m.Unlock()
+		return nil
+	}
+	generation := s.scores.generations[eventID]
+	s.scores.m.Unlock()
+
+	// the state is loaded without the lock, so the scores of other events are not blocked
+	state, err := s.loadScoreState(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	s.scores.m.Lock()
+	defer s.scores.m.Unlock()
+
+	if current, ok := s.scores.events[eventID]; ok {
+		state = current
+	} else if s.scores.generations[eventID] == generation {
+		s.scores.events[eventID] = state
+	}
+
+	fn(state)
+	return nil
+}
+
+func (s *EventService) loadScoreState(ctx context.Context, eventID uuid.UUID) (*eventScoreState, error) {
+	event, err := s.repository.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.repository.GetEventTeams(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenges, err := s.repository.GetEventChallenges(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// archived challenges are excluded from the score, but their solutions are kept
+	archivedChallenges, err := s.getArchivedChallenges(ctx, eventID, challenges)
+	if err != nil {
+		return nil, err
+	}
+
+	solutionsByChallenges, err := s.getSolutionsByChallenges(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	hintUnlocks, err := s.repository.GetEventHintUnlocks(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustments, err := s.repository.GetEventScoreAdjustments(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventScoreState{
+		event:                 event,
+		teams:                 teams,
+		challenges:            challenges,
+		archivedChallenges:    archivedChallenges,
+		solutionsByChallenges: solutionsByChallenges,
+		hintUnlocks:           hintUnlocks,
+		adjustments:           adjustments,
+	}, nil
+}
+
+// addSolution adds the correct solution to the score state of the event without reloading it
+func (e *scoreEngine) addSolution(eventID uuid.UUID, solution postgres.GetAllChallengesSolutionsInEventRow) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	e.generations[eventID]++
+
+	if state, ok := e.events[eventID]; ok {
+		// only the first solution of the team counts, so the repeated one does not change the solve order and points
+		for _, existing := range state.solutionsByChallenges[solution.ChallengeID] {
+			if existing.TeamID == solution.TeamID {
+				return
+			}
+		}
+
+		state.solutionsByChallenges[solution.ChallengeID] = append(state.solutionsByChallenges[solution.ChallengeID], solution)
+		state.score = nil
+		state.frozenScores = nil
+	}
+
+	e.notify(eventID)
+}
+
+// invalidate removes the score state of the event, so it is rebuilt on the next request
+func (e *scoreEngine) invalidate(eventID uuid.UUID) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	e.generations[eventID]++
+	delete(e.events, eventID)
+
+	e.notify(eventID)
+}
+
+// SubscribeScore returns the channel, which receives a notification after each change of the event score,
+// the notifications are not queued, so the subscriber gets the latest score after a series of changes
+func (s *EventService) SubscribeScore(eventID uuid.UUID) (<-chan struct{}, func()) {
+	s.scores.m.Lock()
+	defer s.scores.m.Unlock()
+
+	ch := make(chan struct{}, 1)
+	if s.scores.subscribers[eventID] == nil {
+		s.scores.subscribers[eventID] = make(map[chan struct{}]bool)
+	}
+	s.scores.subscribers[eventID][ch] = true
+
+	unsubscribe := func() {
+		s.scores.m.Lock()
+		defer s.scores.m.Unlock()
+
+		delete(s.scores.subscribers[eventID], ch)
+		if len(s.scores.subscribers[eventID]) == 0 {
+			delete(s.scores.subscribers, eventID)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// notify notifies the subscribers of the event score, must be called under the engine lock
+func (e *scoreEngine) notify(eventID uuid.UUID) {
+	for ch := range e.subscribers[eventID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// the subscriber is already notified
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package event
+
+import (
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/gofrs/uuid"
+	"testing"
+)
+
+func TestQualifiedSolutions(t *testing.T) {
+	teamA, teamB := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	challengeX, challengeY := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+
+	solutions := map[uuid.UUID][]postgres.GetAllChallengesSolutionsInEventRow{
+		challengeX: {{ChallengeID: challengeX, TeamID: teamA}, {ChallengeID: challengeX, TeamID: teamB}},
+		challengeY: {{ChallengeID: challengeY, TeamID: teamB}},
+	}
+
+	tests := []struct {
+		name  string
+		teams []postgres.GetEventTeamsRow
+		want  map[uuid.UUID][]uuid.UUID // challenge -> teams in solve order
+	}{
+		{
+			name:  "no disqualified teams",
+			teams: []postgres.GetEventTeamsRow{{ID: teamA}, {ID: teamB}},
+			want:  map[uuid.UUID][]uuid.UUID{challengeX: {teamA, teamB}, challengeY: {teamB}},
+		},
+		{
+			name:  "solutions of the disqualified team are removed",
+			teams: []postgres.GetEventTeamsRow{{ID: teamA, Disqualified: true}, {ID: teamB}},
+			want:  map[uuid.UUID][]uuid.UUID{challengeX: {teamB}, challengeY: {teamB}},
+		},
+		{
+			name:  "challenge solved only by the disqualified team has no solutions",
+			teams: []postgres.GetEventTeamsRow{{ID: teamA}, {ID: teamB, Disqualified: true}},
+			want:  map[uuid.UUID][]uuid.UUID{challengeX: {teamA}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := qualifiedSolutions(&eventScoreState{teams: tt.teams, solutionsByChallenges: solutions})
+
+			for challengeID, wantTeams := range tt.want {
+				if len(got[challengeID]) != len(wantTeams) {
+					t.Fatalf("challenge has %d solutions, want %d", len(got[challengeID]), len(wantTeams))
+				}
+				for i, teamID := range wantTeams {
+					if got[challengeID][i].TeamID != teamID {
+						t.Errorf("solution %d is of team %s, want %s", i, got[challengeID][i].TeamID, teamID)
+					}
+				}
+			}
+
+			for challengeID := range got {
+				if _, ok := tt.want[challengeID]; !ok && len(got[challengeID]) > 0 {
+					t.Errorf("challenge %s has unexpected solutions", challengeID)
+				}
+			}
+		})
+	}
+}
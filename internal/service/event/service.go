@@ -11,15 +11,23 @@ type (
 	EventService struct {
 		repository      IRepository
 		exerciseService IExerciseService
+		scores          *scoreEngine
 	}
 
 	IRepository interface {
 		IChallengeCategoryRepository
 		ITeamRepository
 		IChallengeRepository
+		IHintRepository
+		IPrerequisiteRepository
+		ICheatingRepository
+		ITeamChallengeRepository
+		ILaboratoryRepository
 		IJoinRepository
 		IScoreRepository
+		IScoreAdjustmentRepository
 		IParticipantRepository
+		IInvitationRepository
 
 		CreateEvent(ctx context.Context, arg postgres.CreateEventParams) error
 		DeleteEvent(ctx context.Context, id uuid.UUID) error
@@ -43,6 +51,7 @@ func NewEventService(deps Dependencies) *EventService {
 	return &EventService{
 		repository:      deps.Repository,
 		exerciseService: deps.ExerciseService,
+		scores:          newScoreEngine(),
 	}
 }
 
@@ -75,29 +84,44 @@ func (s *EventService) GetEvents(ctx context.Context) ([]*model.Event, error) {
 	result := make([]*model.Event, 0, len(events))
 	for _, event := range events {
 		result = append(result, &model.Event{
-			ID:                     event.ID,
-			Type:                   event.Type,
-			Availability:           event.Availability,
-			Participation:          event.Participation,
-			Tag:                    event.Tag,
-			Name:                   event.Name,
-			Description:            event.Description,
-			Rules:                  event.Rules,
-			Picture:                event.Picture,
-			DynamicScoring:         event.DynamicScoring,
-			DynamicMaxScore:        event.DynamicMax,
-			DynamicMinScore:        event.DynamicMin,
-			DynamicSolveThreshold:  event.DynamicSolveThreshold,
-			Registration:           event.Registration,
-			ScoreboardAvailability: event.ScoreboardAvailability,
-			ParticipantsVisibility: event.ParticipantsVisibility,
-			PublishTime:            event.PublishTime,
-			StartTime:              event.StartTime,
-			FinishTime:             event.FinishTime,
-			WithdrawTime:           event.WithdrawTime,
-			CreatedAt:              event.CreatedAt,
-			ChallengesCount:        chaCounts[event.ID],
-			TeamsCount:             teamCounts[event.ID],
+			ID:                       event.ID,
+			Type:                     event.Type,
+			Availability:             event.Availability,
+			Participation:            event.Participation,
+			Tag:                      event.Tag,
+			Name:                     event.Name,
+			Description:              event.Description,
+			Rules:                    event.Rules,
+			Picture:                  event.Picture,
+			DynamicScoring:           event.DynamicScoring,
+			DynamicMaxScore:          event.DynamicMax,
+			DynamicMinScore:          event.DynamicMin,
+			DynamicSolveThreshold:    event.DynamicSolveThreshold,
+			Registration:             event.Registration,
+			ScoreboardAvailability:   event.ScoreboardAvailability,
+			ParticipantsVisibility:   event.ParticipantsVisibility,
+			PublishTime:              event.PublishTime,
+			StartTime:                event.StartTime,
+			FinishTime:               event.FinishTime,
+			WithdrawTime:             event.WithdrawTime,
+			LaboratoriesGracePeriod:  event.LaboratoriesGracePeriod,
+			MaxTeamSize:              event.MaxTeamSize,
+			MaxTeams:                 event.MaxTeams,
+			HideLockedChallenges:     event.HideLockedChallenges,
+			SolutionAttemptsLimit:    event.SolutionAttemptsLimit,
+			SolutionAttemptsWindow:   event.SolutionAttemptsWindow,
+			SolutionAttemptsCooldown: event.SolutionAttemptsCooldown,
+			MaxSolutionAttempts:      event.MaxSolutionAttempts,
+			FlagSharingAction:        event.FlagSharingAction,
+			ScoringStrategy:          event.ScoringStrategy,
+			FirstSolveBonus:          event.FirstSolveBonus,
+			SecondSolveBonus:         event.SecondSolveBonus,
+			ThirdSolveBonus:          event.ThirdSolveBonus,
+			ScoreboardFreezeTime:     fromNullTime(event.ScoreboardFreezeTime),
+			ScoreboardRevealed:       event.ScoreboardRevealed,
+			CreatedAt:                event.CreatedAt,
+			ChallengesCount:          chaCounts[event.ID],
+			TeamsCount:               teamCounts[event.ID],
 		})
 	}
 
@@ -110,27 +134,42 @@ func (s *EventService) GetEventByID(ctx context.Context, eventID uuid.UUID) (*mo
 		return nil, err
 	}
 	return &model.Event{
-		ID:                     event.ID,
-		Type:                   event.Type,
-		Availability:           event.Availability,
-		Participation:          event.Participation,
-		Tag:                    event.Tag,
-		Name:                   event.Name,
-		Description:            event.Description,
-		Rules:                  event.Rules,
-		Picture:                event.Picture,
-		DynamicScoring:         event.DynamicScoring,
-		DynamicMaxScore:        event.DynamicMax,
-		DynamicMinScore:        event.DynamicMin,
-		DynamicSolveThreshold:  event.DynamicSolveThreshold,
-		Registration:           event.Registration,
-		ScoreboardAvailability: event.ScoreboardAvailability,
-		ParticipantsVisibility: event.ParticipantsVisibility,
-		PublishTime:            event.PublishTime,
-		StartTime:              event.StartTime,
-		FinishTime:             event.FinishTime,
-		WithdrawTime:           event.WithdrawTime,
-		CreatedAt:              event.CreatedAt,
+		ID:                       event.ID,
+		Type:                     event.Type,
+		Availability:             event.Availability,
+		Participation:            event.Participation,
+		Tag:                      event.Tag,
+		Name:                     event.Name,
+		Description:              event.Description,
+		Rules:                    event.Rules,
+		Picture:                  event.Picture,
+		DynamicScoring:           event.DynamicScoring,
+		DynamicMaxScore:          event.DynamicMax,
+		DynamicMinScore:          event.DynamicMin,
+		DynamicSolveThreshold:    event.DynamicSolveThreshold,
+		Registration:             event.Registration,
+		ScoreboardAvailability:   event.ScoreboardAvailability,
+		ParticipantsVisibility:   event.ParticipantsVisibility,
+		PublishTime:              event.PublishTime,
+		StartTime:                event.StartTime,
+		FinishTime:               event.FinishTime,
+		WithdrawTime:             event.WithdrawTime,
+		LaboratoriesGracePeriod:  event.LaboratoriesGracePeriod,
+		MaxTeamSize:              event.MaxTeamSize,
+		MaxTeams:                 event.MaxTeams,
+		HideLockedChallenges:     event.HideLockedChallenges,
+		SolutionAttemptsLimit:    event.SolutionAttemptsLimit,
+		SolutionAttemptsWindow:   event.SolutionAttemptsWindow,
+		SolutionAttemptsCooldown: event.SolutionAttemptsCooldown,
+		MaxSolutionAttempts:      event.MaxSolutionAttempts,
+		FlagSharingAction:        event.FlagSharingAction,
+		ScoringStrategy:          event.ScoringStrategy,
+		FirstSolveBonus:          event.FirstSolveBonus,
+		SecondSolveBonus:         event.SecondSolveBonus,
+		ThirdSolveBonus:          event.ThirdSolveBonus,
+		ScoreboardFreezeTime:     fromNullTime(event.ScoreboardFreezeTime),
+		ScoreboardRevealed:       event.ScoreboardRevealed,
+		CreatedAt:                event.CreatedAt,
 	}, nil
 }
 
@@ -140,27 +179,42 @@ func (s *EventService) GetEventByTag(ctx context.Context, eventTag string) (*mod
 		return nil, err
 	}
 	return &model.Event{
-		ID:                     event.ID,
-		Type:                   event.Type,
-		Availability:           event.Availability,
-		Participation:          event.Participation,
-		Tag:                    event.Tag,
-		Name:                   event.Name,
-		Description:            event.Description,
-		Rules:                  event.Rules,
-		Picture:                event.Picture,
-		DynamicScoring:         event.DynamicScoring,
-		DynamicMaxScore:        event.DynamicMax,
-		DynamicMinScore:        event.DynamicMin,
-		DynamicSolveThreshold:  event.DynamicSolveThreshold,
-		Registration:           event.Registration,
-		ScoreboardAvailability: event.ScoreboardAvailability,
-		ParticipantsVisibility: event.ParticipantsVisibility,
-		PublishTime:            event.PublishTime,
-		StartTime:              event.StartTime,
-		FinishTime:             event.FinishTime,
-		WithdrawTime:           event.WithdrawTime,
-		CreatedAt:              event.CreatedAt,
+		ID:                       event.ID,
+		Type:                     event.Type,
+		Availability:             event.Availability,
+		Participation:            event.Participation,
+		Tag:                      event.Tag,
+		Name:                     event.Name,
+		Description:              event.Description,
+		Rules:                    event.Rules,
+		Picture:                  event.Picture,
+		DynamicScoring:           event.DynamicScoring,
+		DynamicMaxScore:          event.DynamicMax,
+		DynamicMinScore:          event.DynamicMin,
+		DynamicSolveThreshold:    event.DynamicSolveThreshold,
+		Registration:             event.Registration,
+		ScoreboardAvailability:   event.ScoreboardAvailability,
+		ParticipantsVisibility:   event.ParticipantsVisibility,
+		PublishTime:              event.PublishTime,
+		StartTime:                event.StartTime,
+		FinishTime:               event.FinishTime,
+		WithdrawTime:             event.WithdrawTime,
+		LaboratoriesGracePeriod:  event.LaboratoriesGracePeriod,
+		MaxTeamSize:              event.MaxTeamSize,
+		MaxTeams:                 event.MaxTeams,
+		HideLockedChallenges:     event.HideLockedChallenges,
+		SolutionAttemptsLimit:    event.SolutionAttemptsLimit,
+		SolutionAttemptsWindow:   event.SolutionAttemptsWindow,
+		SolutionAttemptsCooldown: event.SolutionAttemptsCooldown,
+		MaxSolutionAttempts:      event.MaxSolutionAttempts,
+		FlagSharingAction:        event.FlagSharingAction,
+		ScoringStrategy:          event.ScoringStrategy,
+		FirstSolveBonus:          event.FirstSolveBonus,
+		SecondSolveBonus:         event.SecondSolveBonus,
+		ThirdSolveBonus:          event.ThirdSolveBonus,
+		ScoreboardFreezeTime:     fromNullTime(event.ScoreboardFreezeTime),
+		ScoreboardRevealed:       event.ScoreboardRevealed,
+		CreatedAt:                event.CreatedAt,
 	}, nil
 }
 
@@ -168,26 +222,40 @@ func (s *EventService) CreateEvent(ctx context.Context, event *model.Event) (*mo
 	event.ID = uuid.Must(uuid.NewV7())
 
 	if err := s.repository.CreateEvent(ctx, postgres.CreateEventParams{
-		ID:                     event.ID,
-		Type:                   event.Type,
-		Availability:           event.Availability,
-		Participation:          event.Participation,
-		Tag:                    event.Tag,
-		Name:                   event.Name,
-		Description:            event.Description,
-		Rules:                  event.Rules,
-		Picture:                event.Picture,
-		DynamicScoring:         event.DynamicScoring,
-		DynamicMax:             event.DynamicMaxScore,
-		DynamicMin:             event.DynamicMinScore,
-		DynamicSolveThreshold:  event.DynamicSolveThreshold,
-		Registration:           event.Registration,
-		ScoreboardAvailability: event.ScoreboardAvailability,
-		ParticipantsVisibility: event.ParticipantsVisibility,
-		PublishTime:            event.PublishTime,
-		StartTime:              event.StartTime,
-		FinishTime:             event.FinishTime,
-		WithdrawTime:           event.WithdrawTime,
+		ID:                       event.ID,
+		Type:                     event.Type,
+		Availability:             event.Availability,
+		Participation:            event.Participation,
+		Tag:                      event.Tag,
+		Name:                     event.Name,
+		Description:              event.Description,
+		Rules:                    event.Rules,
+		Picture:                  event.Picture,
+		DynamicScoring:           event.DynamicScoring,
+		DynamicMax:               event.DynamicMaxScore,
+		DynamicMin:               event.DynamicMinScore,
+		DynamicSolveThreshold:    event.DynamicSolveThreshold,
+		Registration:             event.Registration,
+		ScoreboardAvailability:   event.ScoreboardAvailability,
+		ParticipantsVisibility:   event.ParticipantsVisibility,
+		PublishTime:              event.PublishTime,
+		StartTime:                event.StartTime,
+		FinishTime:               event.FinishTime,
+		WithdrawTime:             event.WithdrawTime,
+		LaboratoriesGracePeriod:  event.LaboratoriesGracePeriod,
+		MaxTeamSize:              event.MaxTeamSize,
+		MaxTeams:                 event.MaxTeams,
+		HideLockedChallenges:     event.HideLockedChallenges,
+		SolutionAttemptsLimit:    event.SolutionAttemptsLimit,
+		SolutionAttemptsWindow:   event.SolutionAttemptsWindow,
+		SolutionAttemptsCooldown: event.SolutionAttemptsCooldown,
+		MaxSolutionAttempts:      event.MaxSolutionAttempts,
+		FlagSharingAction:        event.FlagSharingAction,
+		ScoringStrategy:          event.ScoringStrategy,
+		FirstSolveBonus:          event.FirstSolveBonus,
+		SecondSolveBonus:         event.SecondSolveBonus,
+		ThirdSolveBonus:          event.ThirdSolveBonus,
+		ScoreboardFreezeTime:     toNullTime(event.ScoreboardFreezeTime),
 	}); err != nil {
 		return nil, err
 	}
@@ -196,25 +264,42 @@ func (s *EventService) CreateEvent(ctx context.Context, event *model.Event) (*mo
 
 func (s *EventService) UpdateEvent(ctx context.Context, event *model.Event) error {
 	if err := s.repository.UpdateEvent(ctx, postgres.UpdateEventParams{
-		ID:                     event.ID,
-		Name:                   event.Name,
-		Description:            event.Description,
-		Rules:                  event.Rules,
-		Picture:                event.Picture,
-		DynamicScoring:         event.DynamicScoring,
-		DynamicMax:             event.DynamicMaxScore,
-		DynamicMin:             event.DynamicMinScore,
-		DynamicSolveThreshold:  event.DynamicSolveThreshold,
-		Registration:           event.Registration,
-		ScoreboardAvailability: event.ScoreboardAvailability,
-		ParticipantsVisibility: event.ParticipantsVisibility,
-		PublishTime:            event.PublishTime,
-		StartTime:              event.StartTime,
-		FinishTime:             event.FinishTime,
-		WithdrawTime:           event.WithdrawTime,
+		ID:                       event.ID,
+		Name:                     event.Name,
+		Description:              event.Description,
+		Rules:                    event.Rules,
+		Picture:                  event.Picture,
+		DynamicScoring:           event.DynamicScoring,
+		DynamicMax:               event.DynamicMaxScore,
+		DynamicMin:               event.DynamicMinScore,
+		DynamicSolveThreshold:    event.DynamicSolveThreshold,
+		Registration:             event.Registration,
+		ScoreboardAvailability:   event.ScoreboardAvailability,
+		ParticipantsVisibility:   event.ParticipantsVisibility,
+		PublishTime:              event.PublishTime,
+		StartTime:                event.StartTime,
+		FinishTime:               event.FinishTime,
+		WithdrawTime:             event.WithdrawTime,
+		LaboratoriesGracePeriod:  event.LaboratoriesGracePeriod,
+		MaxTeamSize:              event.MaxTeamSize,
+		MaxTeams:                 event.MaxTeams,
+		HideLockedChallenges:     event.HideLockedChallenges,
+		SolutionAttemptsLimit:    event.SolutionAttemptsLimit,
+		SolutionAttemptsWindow:   event.SolutionAttemptsWindow,
+		SolutionAttemptsCooldown: event.SolutionAttemptsCooldown,
+		MaxSolutionAttempts:      event.MaxSolutionAttempts,
+		FlagSharingAction:        event.FlagSharingAction,
+		ScoringStrategy:          event.ScoringStrategy,
+		FirstSolveBonus:          event.FirstSolveBonus,
+		SecondSolveBonus:         event.SecondSolveBonus,
+		ThirdSolveBonus:          event.ThirdSolveBonus,
+		ScoreboardFreezeTime:     toNullTime(event.ScoreboardFreezeTime),
 	}); err != nil {
 		return err
 	}
+
+	s.scores.invalidate(event.ID)
+
 	return nil
 }
 
@@ -222,5 +307,8 @@ func (s *EventService) DeleteEvent(ctx context.Context, eventID uuid.UUID) error
 	if err := s.repository.DeleteEvent(ctx, eventID); err != nil {
 		return err
 	}
+
+	s.scores.invalidate(eventID)
+
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/internal/tools"
@@ -16,12 +17,23 @@ type (
 		CreateTeamInEvent(ctx context.Context, arg postgres.CreateTeamInEventParams) error
 		GetEventTeamByName(ctx context.Context, arg postgres.GetEventTeamByNameParams) (postgres.GetEventTeamByNameRow, error)
 		GetEventTeams(ctx context.Context, eventID uuid.UUID) ([]postgres.GetEventTeamsRow, error)
+		GetEventTeamByID(ctx context.Context, arg postgres.GetEventTeamByIDParams) (postgres.GetEventTeamByIDRow, error)
 		TeamExistsInEvent(ctx context.Context, arg postgres.TeamExistsInEventParams) (bool, error)
 
 		GetEventParticipantTeam(ctx context.Context, arg postgres.GetEventParticipantTeamParams) (postgres.GetEventParticipantTeamRow, error)
 		GetEventParticipantTeamID(ctx context.Context, arg postgres.GetEventParticipantTeamIDParams) (uuid.NullUUID, error)
 
 		UpdateEventParticipantTeam(ctx context.Context, arg postgres.UpdateEventParticipantTeamParams) error
+
+		GetEventTeamMembers(ctx context.Context, arg postgres.GetEventTeamMembersParams) ([]postgres.GetEventTeamMembersRow, error)
+		UpdateEventTeamName(ctx context.Context, arg postgres.UpdateEventTeamNameParams) error
+		UpdateEventTeamJoinCode(ctx context.Context, arg postgres.UpdateEventTeamJoinCodeParams) error
+		UpdateEventTeamCaptain(ctx context.Context, arg postgres.UpdateEventTeamCaptainParams) error
+		DeleteEventTeam(ctx context.Context, arg postgres.DeleteEventTeamParams) error
+		EventTeamActivityExists(ctx context.Context, teamID uuid.UUID) (bool, error)
+
+		CountEventTeams(ctx context.Context, eventID uuid.UUID) (int64, error)
+		CountEventTeamMembers(ctx context.Context, teamID uuid.NullUUID) (int64, error)
 	}
 )
 
@@ -37,12 +49,18 @@ func (s *EventService) GetEventTeams(ctx context.Context, eventID uuid.UUID) ([]
 			ID:           team.ID,
 			Name:         team.Name,
 			LaboratoryID: team.LaboratoryID,
+			CaptainID:    team.CaptainID,
+			Disqualified: team.Disqualified,
 		})
 	}
 
 	return result, nil
 }
 
+func (s *EventService) CountEventTeams(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	return s.repository.CountEventTeams(ctx, eventID)
+}
+
 func (s *EventService) GetParticipantTeam(ctx context.Context, eventID, userID uuid.UUID) (*model.Team, error) {
 	team, err := s.repository.GetEventParticipantTeam(ctx, postgres.GetEventParticipantTeamParams{
 		EventID: eventID,
@@ -58,44 +76,56 @@ func (s *EventService) GetParticipantTeam(ctx context.Context, eventID, userID u
 	return &model.Team{
 		ID:           team.ID,
 		Name:         team.Name,
+		JoinCode:     team.JoinCode,
 		LaboratoryID: team.LaboratoryID,
+		CaptainID:    team.CaptainID,
+		Disqualified: team.Disqualified,
 	}, nil
 }
 
-func (s *EventService) CreateTeam(ctx context.Context, eventID uuid.UUID, name string, laboratoryID *uuid.UUID) error {
+func (s *EventService) CreateTeam(ctx context.Context, eventID, userID uuid.UUID, name string, laboratoryID *uuid.UUID) (*model.Team, error) {
 	// check if team exists
 	exists, err := s.repository.TeamExistsInEvent(ctx, postgres.TeamExistsInEventParams{
 		EventID: eventID,
 		Name:    name,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if exists {
-		return model.ErrTeamExists
+		return nil, model.ErrTeamExists
 	}
 
-	teamID := uuid.Must(uuid.NewV7())
-
-	// create team
-	if err = s.repository.CreateTeamInEvent(ctx, postgres.CreateTeamInEventParams{
-		ID:       teamID,
+	team := &model.Team{
+		ID:       uuid.Must(uuid.NewV7()),
+		EventID:  eventID,
 		Name:     name,
 		JoinCode: uuid.Must(uuid.NewV4()).String(),
-		EventID:  eventID,
-		LaboratoryID: uuid.NullUUID{
-			UUID:  *laboratoryID,
-			Valid: laboratoryID != nil,
+		// creator of the team is its captain
+		CaptainID: uuid.NullUUID{
+			UUID:  userID,
+			Valid: true,
 		},
-	}); err != nil {
-		return err
 	}
 
-	// get current user id
-	userID, err := tools.GetCurrentUserIDFromContext(ctx)
-	if err != nil {
-		return err
+	if laboratoryID != nil {
+		team.LaboratoryID = uuid.NullUUID{
+			UUID:  *laboratoryID,
+			Valid: true,
+		}
+	}
+
+	// create team
+	if err = s.repository.CreateTeamInEvent(ctx, postgres.CreateTeamInEventParams{
+		ID:           team.ID,
+		Name:         team.Name,
+		JoinCode:     team.JoinCode,
+		EventID:      team.EventID,
+		LaboratoryID: team.LaboratoryID,
+		CaptainID:    team.CaptainID,
+	}); err != nil {
+		return nil, err
 	}
 
 	// update participant team
@@ -103,17 +133,20 @@ func (s *EventService) CreateTeam(ctx context.Context, eventID uuid.UUID, name s
 		EventID: eventID,
 		UserID:  userID,
 		TeamID: uuid.NullUUID{
-			UUID:  teamID,
+			UUID:  team.ID,
 			Valid: true,
 		},
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	s.scores.invalidate(eventID)
+
+	return team, nil
 }
 
-func (s *EventService) JoinTeam(ctx context.Context, eventID uuid.UUID, name, joinCode string) error {
+// JoinTeam adds the current user to the team, maxTeamSize 0 is unlimited
+func (s *EventService) JoinTeam(ctx context.Context, eventID uuid.UUID, name, joinCode string, maxTeamSize int32) error {
 	team, err := s.repository.GetEventTeamByName(ctx, postgres.GetEventTeamByNameParams{
 		EventID: eventID,
 		Name:    name,
@@ -129,6 +162,20 @@ func (s *EventService) JoinTeam(ctx context.Context, eventID uuid.UUID, name, jo
 		return model.ErrTeamWrongCredentials
 	}
 
+	if maxTeamSize > 0 {
+		membersCount, err := s.repository.CountEventTeamMembers(ctx, uuid.NullUUID{
+			UUID:  team.ID,
+			Valid: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if membersCount >= int64(maxTeamSize) {
+			return model.ErrTeamFull
+		}
+	}
+
 	// get current user id
 	userID, err := tools.GetCurrentUserIDFromContext(ctx)
 	if err != nil {
@@ -149,3 +196,168 @@ func (s *EventService) JoinTeam(ctx context.Context, eventID uuid.UUID, name, jo
 
 	return nil
 }
+
+func (s *EventService) GetTeamMembers(ctx context.Context, eventID, teamID uuid.UUID) ([]*model.TeamMember, error) {
+	members, err := s.repository.GetEventTeamMembers(ctx, postgres.GetEventTeamMembersParams{
+		EventID: eventID,
+		TeamID: uuid.NullUUID{
+			UUID:  teamID,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.TeamMember, 0, len(members))
+	for _, member := range members {
+		result = append(result, &model.TeamMember{
+			UserID: member.UserID,
+			Name:   member.Name,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *EventService) RenameTeam(ctx context.Context, eventID, teamID uuid.UUID, name string) error {
+	// check if team exists
+	exists, err := s.repository.TeamExistsInEvent(ctx, postgres.TeamExistsInEventParams{
+		EventID: eventID,
+		Name:    name,
+	})
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return model.ErrTeamExists
+	}
+
+	// get current user id
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repository.UpdateEventTeamName(ctx, postgres.UpdateEventTeamNameParams{
+		ID:   teamID,
+		Name: name,
+		UpdatedBy: uuid.NullUUID{
+			UUID:  userID,
+			Valid: true,
+		},
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
+
+// RegenerateTeamJoinCode replaces the join code of the team, so the old one can not be used to join the team
+func (s *EventService) RegenerateTeamJoinCode(ctx context.Context, teamID uuid.UUID) (string, error) {
+	// get current user id
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	joinCode := uuid.Must(uuid.NewV4()).String()
+
+	if err = s.repository.UpdateEventTeamJoinCode(ctx, postgres.UpdateEventTeamJoinCodeParams{
+		ID:       teamID,
+		JoinCode: joinCode,
+		UpdatedBy: uuid.NullUUID{
+			UUID:  userID,
+			Valid: true,
+		},
+	}); err != nil {
+		return "", err
+	}
+	return joinCode, nil
+}
+
+// UpdateTeamCaptain sets the captain of the team, nil captainID means the team has no captain
+func (s *EventService) UpdateTeamCaptain(ctx context.Context, teamID uuid.UUID, captainID *uuid.UUID) error {
+	// get current user id
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	captain := uuid.NullUUID{}
+	if captainID != nil {
+		captain = uuid.NullUUID{
+			UUID:  *captainID,
+			Valid: true,
+		}
+	}
+
+	if err = s.repository.UpdateEventTeamCaptain(ctx, postgres.UpdateEventTeamCaptainParams{
+		ID:        teamID,
+		CaptainID: captain,
+		UpdatedBy: uuid.NullUUID{
+			UUID:  userID,
+			Valid: true,
+		},
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoveParticipantFromTeam removes the participant from the team and revokes the participant vpn client
+func (s *EventService) RemoveParticipantFromTeam(ctx context.Context, eventID, userID uuid.UUID) error {
+	if err := s.repository.UpdateEventParticipantTeam(ctx, postgres.UpdateEventParticipantTeamParams{
+		EventID: eventID,
+		UserID:  userID,
+		TeamID:  uuid.NullUUID{},
+	}); err != nil {
+		return err
+	}
+
+	// vpn client gives access to the laboratory of the team
+	if err := s.repository.DeleteClient(ctx, fmt.Sprintf("%s-%s", eventID.String(), userID.String())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TeamHasActivity returns true if the team has the solution attempts or takes part in the cheating incidents
+func (s *EventService) TeamHasActivity(ctx context.Context, teamID uuid.UUID) (bool, error) {
+	return s.repository.EventTeamActivityExists(ctx, teamID)
+}
+
+// DeleteTeam deletes the team with its laboratory
+func (s *EventService) DeleteTeam(ctx context.Context, eventID, teamID uuid.UUID) error {
+	team, err := s.repository.GetEventTeamByID(ctx, postgres.GetEventTeamByIDParams{
+		ID:      teamID,
+		EventID: eventID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ErrTeamNotFound
+		}
+		return err
+	}
+
+	if team.LaboratoryID.Valid {
+		if err = s.repository.DeleteLabs(ctx, team.LaboratoryID.UUID); err != nil {
+			return err
+		}
+	}
+
+	if err = s.repository.DeleteEventTeam(ctx, postgres.DeleteEventTeamParams{
+		ID:      teamID,
+		EventID: eventID,
+	}); err != nil {
+		return err
+	}
+
+	s.scores.invalidate(eventID)
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-multierror"
+	"slices"
+)
+
+type (
+	ITeamChallengeRepository interface {
+		CreateEventTeamChallenge(ctx context.Context, arg postgres.CreateEventTeamChallengeParams) error
+		GetEventTeamChallenges(ctx context.Context, arg postgres.GetEventTeamChallengesParams) ([]postgres.GetEventTeamChallengesRow, error)
+		DeleteEventTeamChallenge(ctx context.Context, id uuid.UUID) error
+
+		GetEventTeamLabChallenges(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error)
+		CreateEventTeamLabChallenge(ctx context.Context, arg postgres.CreateEventTeamLabChallengeParams) error
+		DeleteEventTeamLabChallenge(ctx context.Context, arg postgres.DeleteEventTeamLabChallengeParams) error
+		DeleteEventTeamLabChallenges(ctx context.Context, teamID uuid.UUID) error
+
+		UpdateEventTeamLaboratory(ctx context.Context, arg postgres.UpdateEventTeamLaboratoryParams) error
+
+		GetLabs(ctx context.Context, labIDs ...uuid.UUID) ([]*model.LabInfo, error)
+		CreateLab(ctx context.Context, mask int) (uuid.UUID, error)
+		AddLabChallenges(ctx context.Context, labID uuid.UUID, configs []model.LabChallenge) error
+		DeleteLabsChallenges(ctx context.Context, labIDs []uuid.UUID, exerciseIDs []uuid.UUID) error
+	}
+)
+
+// ReconcileEventChallenges brings challenges and laboratories of all event teams to the desired state,
+// the agent does not report the challenges deployed to the laboratory, so with redeploy all of them are deployed again
+// to repair the laboratories changed outside the platform
+func (s *EventService) ReconcileEventChallenges(ctx context.Context, eventID uuid.UUID, redeploy bool) error {
+	// get all teams in event
+	teams, err := s.repository.GetEventTeams(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	// get all challenges in event, which have to be deployed now
+	challenges, err := s.repository.GetEventChallenges(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if challenges, err = s.getProvisionedChallenges(ctx, eventID, challenges); err != nil {
+		return err
+	}
+
+	labIDs := make([]uuid.UUID, 0, len(teams))
+	for _, team := range teams {
+		if team.LaboratoryID.Valid {
+			labIDs = append(labIDs, team.LaboratoryID.UUID)
+		}
+	}
+
+	existingLabs, err := s.getExistingLabs(ctx, labIDs...)
+	if err != nil {
+		return err
+	}
+
+	exercises, errs := s.getChallengesExercises(ctx, challenges)
+
+	for _, team := range teams {
+		if err = s.reconcileTeamChallenges(ctx, eventID, team.ID, team.LaboratoryID, existingLabs, challenges, exercises, redeploy); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	return nil
+}
+
+// ReconcileEventTeamChallenges brings challenges and laboratory of the event team to the desired state,
+// with redeploy all challenges are deployed to the team laboratory again
+func (s *EventService) ReconcileEventTeamChallenges(ctx context.Context, eventID, teamID uuid.UUID, redeploy bool) error {
+	team, err := s.repository.GetEventTeamByID(ctx, postgres.GetEventTeamByIDParams{
+		ID:      teamID,
+		EventID: eventID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ErrTeamNotFound
+		}
+		return err
+	}
+
+	// get all challenges in event, which have to be deployed now
+	challenges, err := s.repository.GetEventChallenges(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if challenges, err = s.getProvisionedChallenges(ctx, eventID, challenges); err != nil {
+		return err
+	}
+
+	existingLabs := make(map[uuid.UUID]bool)
+	if team.LaboratoryID.Valid {
+		if existingLabs, err = s.getExistingLabs(ctx, team.LaboratoryID.UUID); err != nil {
+			return err
+		}
+	}
+
+	exercises, errs := s.getChallengesExercises(ctx, challenges)
+
+	if err = s.reconcileTeamChallenges(ctx, eventID, team.ID, team.LaboratoryID, existingLabs, challenges, exercises, redeploy); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	return nil
+}
+
+func (s *EventService) reconcileTeamChallenges(ctx context.Context, eventID, teamID uuid.UUID, laboratoryID uuid.NullUUID, existingLabs map[uuid.UUID]bool, challenges []postgres.EventChallenge, exercises map[uuid.UUID]*model.Exercise, redeploy bool) error {
+	// if the team laboratory is lost, create a new one and deploy all exercises again
+	if !laboratoryID.Valid || !existingLabs[laboratoryID.UUID] {
+		labID, err := s.repository.CreateLab(ctx, config.LaboratoryCIDRMask)
+		if err != nil {
+			return err
+		}
+
+		laboratoryID = uuid.NullUUID{UUID: labID, Valid: true}
+
+		if err = s.repository.UpdateEventTeamLaboratory(ctx, postgres.UpdateEventTeamLaboratoryParams{
+			ID:           teamID,
+			LaboratoryID: laboratoryID,
+		}); err != nil {
+			return err
+		}
+
+		if err = s.repository.DeleteEventTeamLabChallenges(ctx, teamID); err != nil {
+			return err
+		}
+	}
+
+	teamChallenges, err := s.repository.GetEventTeamChallenges(ctx, postgres.GetEventTeamChallengesParams{
+		EventID: eventID,
+		TeamID:  teamID,
+	})
+	if err != nil {
+		return err
+	}
+
+	deployedExercises, err := s.repository.GetEventTeamLabChallenges(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+
+	desiredChallenges := make(map[uuid.UUID]postgres.EventChallenge, len(challenges))
+	for _, challenge := range challenges {
+		desiredChallenges[challenge.ID] = challenge
+	}
+
+	// map[taskID]flag
+	flags := make(map[uuid.UUID]string)
+	existingChallenges := make(map[uuid.UUID]bool)
+
+	// remove team challenges which are not in the event anymore
+	for _, teamChallenge := range teamChallenges {
+		challenge, ok := desiredChallenges[teamChallenge.ChallengeID]
+		if !ok {
+			if err = s.repository.DeleteEventTeamChallenge(ctx, teamChallenge.ID); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+			continue
+		}
+
+		existingChallenges[challenge.ID] = true
+		flags[challenge.ExerciseTaskID] = teamChallenge.Flag
+	}
+
+	// create missing team challenges, exercises of the new challenges have to be redeployed to get the new flags
+	desiredExercises := make(map[uuid.UUID]bool)
+	changedExercises := make(map[uuid.UUID]bool)
+	for _, challenge := range challenges {
+		desiredExercises[challenge.ExerciseID] = true
+
+		// exercise could not be got, keep the team challenges as they are
+		exercise, ok := exercises[challenge.ExerciseID]
+		if !ok || existingChallenges[challenge.ID] {
+			continue
+		}
+
+		flag, err := getChallengeFlag(exercise, challenge.ExerciseTaskID)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		if err = s.repository.CreateEventTeamChallenge(ctx, postgres.CreateEventTeamChallengeParams{
+			ID:          uuid.Must(uuid.NewV7()),
+			EventID:     eventID,
+			TeamID:      teamID,
+			ChallengeID: challenge.ID,
+			Flag:        flag,
+		}); err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		flags[challenge.ExerciseTaskID] = flag
+		changedExercises[exercise.ID] = true
+	}
+
+	// on redeploy the exercises are removed from the laboratory even if they are not recorded as deployed,
+	// because the deployment could fail partially, the removal of the missing exercise does nothing
+	if redeploy {
+		for exerciseID := range desiredExercises {
+			if _, ok := exercises[exerciseID]; ok && !changedExercises[exerciseID] {
+				changedExercises[exerciseID] = true
+				if !slices.Contains(deployedExercises, exerciseID) {
+					deployedExercises = append(deployedExercises, exerciseID)
+				}
+			}
+		}
+	}
+
+	// remove stale exercises from the team laboratory
+	staleExercises := make([]uuid.UUID, 0)
+	existingExercises := make(map[uuid.UUID]bool)
+	for _, exerciseID := range deployedExercises {
+		if !desiredExercises[exerciseID] || changedExercises[exerciseID] {
+			staleExercises = append(staleExercises, exerciseID)
+			continue
+		}
+		existingExercises[exerciseID] = true
+	}
+
+	if len(staleExercises) > 0 {
+		if err = s.repository.DeleteLabsChallenges(ctx, []uuid.UUID{laboratoryID.UUID}, staleExercises); err != nil {
+			return multierror.Append(errs, err)
+		}
+
+		for _, exerciseID := range staleExercises {
+			if err = s.repository.DeleteEventTeamLabChallenge(ctx, postgres.DeleteEventTeamLabChallengeParams{
+				TeamID:     teamID,
+				ExerciseID: exerciseID,
+			}); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
+
+	// deploy missing exercises to the team laboratory
+	labChallenges := make([]model.LabChallenge, 0)
+	for exerciseID := range desiredExercises {
+		exercise, ok := exercises[exerciseID]
+		if !ok || existingExercises[exerciseID] {
+			continue
+		}
+
+		labChallenges = append(labChallenges, model.LabChallenge{
+			ID:        exerciseID,
+			Instances: getLabInstances(exercise, flags),
+		})
+	}
+
+	if len(labChallenges) > 0 {
+		if err = s.repository.AddLabChallenges(ctx, laboratoryID.UUID, labChallenges); err != nil {
+			return multierror.Append(errs, err)
+		}
+
+		for _, labChallenge := range labChallenges {
+			if err = s.repository.CreateEventTeamLabChallenge(ctx, postgres.CreateEventTeamLabChallengeParams{
+				EventID:    eventID,
+				TeamID:     teamID,
+				ExerciseID: labChallenge.ID,
+			}); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	return nil
+}
+
+func (s *EventService) getExistingLabs(ctx context.Context, labIDs ...uuid.UUID) (map[uuid.UUID]bool, error) {
+	existingLabs := make(map[uuid.UUID]bool)
+
+	if len(labIDs) == 0 {
+		return existingLabs, nil
+	}
+
+	labs, err := s.repository.GetLabs(ctx, labIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lab := range labs {
+		existingLabs[lab.ID] = true
+	}
+
+	return existingLabs, nil
+}
+
+func (s *EventService) getChallengesExercises(ctx context.Context, challenges []postgres.EventChallenge) (map[uuid.UUID]*model.Exercise, error) {
+	var errs error
+
+	exercises := make(map[uuid.UUID]*model.Exercise)
+	for _, challenge := range challenges {
+		if _, ok := exercises[challenge.ExerciseID]; ok {
+			continue
+		}
+
+		exercise, err := s.exerciseService.GetExercise(ctx, challenge.ExerciseID)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		exercises[challenge.ExerciseID] = exercise
+	}
+
+	return exercises, errs
+}
+
+func getChallengeFlag(exercise *model.Exercise, taskID uuid.UUID) (string, error) {
+	for _, task := range exercise.Data.Tasks {
+		if task.ID == taskID {
+			return tools.GetSolutionForTask(task.Flags...)
+		}
+	}
+
+	return "", model.ErrChallengeTaskNotFound
+}
+
+func getLabInstances(exercise *model.Exercise, flags map[uuid.UUID]string) []model.Instance {
+	instances := make([]model.Instance, 0, len(exercise.Data.Instances))
+
+	// the flag of the regex task is the pattern, not the answer, so it is not given to the instance
+	regexTasks := make(map[uuid.UUID]bool)
+	for _, task := range exercise.Data.Tasks {
+		if task.FlagMatchMode == model.RegexFlagMatchMode {
+			regexTasks[task.ID] = true
+		}
+	}
+
+	for _, instance := range exercise.Data.Instances {
+		// copy envs to not modify the exercise, it is shared between teams
+		envs := make([]model.EnvVar, 0, len(instance.EnvVars)+1)
+		envs = append(envs, instance.EnvVars...)
+
+		// if instance has flag var add it to envs
+		if instance.LinkedTaskID.Valid && !regexTasks[instance.LinkedTaskID.UUID] {
+			envs = append(envs, model.EnvVar{
+				Name:  instance.InstanceFlagVar,
+				Value: flags[instance.LinkedTaskID.UUID],
+			})
+		}
+
+		instances = append(instances, model.Instance{
+			ID:              instance.ID,
+			Name:            instance.Name,
+			Image:           instance.Image,
+			LinkedTaskID:    instance.LinkedTaskID,
+			InstanceFlagVar: instance.InstanceFlagVar,
+			EnvVars:         envs,
+			DNSRecords:      instance.DNSRecords,
+		})
+	}
+
+	return instances
+}
@@ -0,0 +1,143 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/delivery/repository/postgres"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type (
+	JobService struct {
+		repository IRepository
+	}
+
+	IRepository interface {
+		CreateJob(ctx context.Context, arg postgres.CreateJobParams) error
+		GetJobByKey(ctx context.Context, key string) (postgres.Job, error)
+		GetJobsByStatus(ctx context.Context, status int32) ([]postgres.Job, error)
+		UpdateJobAttempt(ctx context.Context, arg postgres.UpdateJobAttemptParams) error
+		DeleteJob(ctx context.Context, arg postgres.DeleteJobParams) error
+		DeleteJobByKey(ctx context.Context, key string) error
+	}
+
+	Dependencies struct {
+		Repository IRepository
+	}
+)
+
+func NewJobService(deps Dependencies) *JobService {
+	return &JobService{
+		repository: deps.Repository,
+	}
+}
+
+// ScheduleJob creates the job or replaces the job with the same key
+func (s *JobService) ScheduleJob(ctx context.Context, job *model.Job) error {
+	if err := s.repository.CreateJob(ctx, postgres.CreateJobParams{
+		ID:      uuid.Must(uuid.NewV7()),
+		JobType: job.Type,
+		Key:     job.Key,
+		Payload: job.Payload,
+		RunAt:   job.RunAt,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *JobService) GetJob(ctx context.Context, key string) (*model.Job, error) {
+	job, err := s.repository.GetJobByKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrJobNotFound
+		}
+		return nil, err
+	}
+
+	return &model.Job{
+		ID:        job.ID,
+		Type:      job.JobType,
+		Key:       job.Key,
+		Payload:   job.Payload,
+		RunAt:     job.RunAt,
+		Attempts:  job.Attempts,
+		LastError: job.LastError,
+		Status:    job.Status,
+		CreatedAt: job.CreatedAt,
+	}, nil
+}
+
+func (s *JobService) GetPendingJobs(ctx context.Context) ([]*model.Job, error) {
+	jobs, err := s.repository.GetJobsByStatus(ctx, model.PendingJobStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Job, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, &model.Job{
+			ID:        job.ID,
+			Type:      job.JobType,
+			Key:       job.Key,
+			Payload:   job.Payload,
+			RunAt:     job.RunAt,
+			Attempts:  job.Attempts,
+			LastError: job.LastError,
+			Status:    job.Status,
+			CreatedAt: job.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// CompleteJob deletes the done job, if it is not rescheduled while running
+func (s *JobService) CompleteJob(ctx context.Context, job *model.Job) error {
+	if err := s.repository.DeleteJob(ctx, postgres.DeleteJobParams{
+		ID:    job.ID,
+		RunAt: job.RunAt,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FailJob records the failed attempt and schedules the retry with exponential backoff,
+// the job is marked as failed when all attempts are used
+func (s *JobService) FailJob(ctx context.Context, job *model.Job, jobErr error) error {
+	job.Attempts++
+	job.LastError = jobErr.Error()
+
+	if job.Attempts >= config.JobMaxAttempts {
+		job.Status = model.FailedJobStatus
+	} else {
+		delay := config.JobRetryBaseDelay << (job.Attempts - 1)
+		if delay > config.JobRetryMaxDelay {
+			delay = config.JobRetryMaxDelay
+		}
+		job.RunAt = time.Now().UTC().Add(delay)
+	}
+
+	if err := s.repository.UpdateJobAttempt(ctx, postgres.UpdateJobAttemptParams{
+		ID:        job.ID,
+		RunAt:     job.RunAt,
+		Attempts:  job.Attempts,
+		LastError: job.LastError,
+		Status:    job.Status,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *JobService) CancelJob(ctx context.Context, key string) error {
+	if err := s.repository.DeleteJobByKey(ctx, key); err != nil {
+		return err
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/cybericebox/daemon/internal/service/email"
 	"github.com/cybericebox/daemon/internal/service/event"
 	"github.com/cybericebox/daemon/internal/service/exercise"
+	"github.com/cybericebox/daemon/internal/service/job"
 	"github.com/cybericebox/daemon/internal/service/laboratory"
 	"github.com/cybericebox/daemon/internal/service/oauth"
 	"github.com/cybericebox/daemon/internal/service/storage"
@@ -26,6 +27,7 @@ type (
 		*event.EventService
 		*exercise.ExerciseService
 		*laboratory.LaboratoryService
+		*job.JobService
 	}
 
 	IRepository interface {
@@ -36,6 +38,7 @@ type (
 		event.IRepository
 		exercise.IRepository
 		laboratory.IRepository
+		job.IRepository
 	}
 
 	Dependencies struct {
@@ -70,5 +73,6 @@ func NewService(deps Dependencies) *Service {
 		}),
 		ExerciseService:   exerciseService,
 		LaboratoryService: laboratory.NewLaboratoryService(laboratory.Dependencies{Repository: deps.Repository}),
+		JobService:        job.NewJobService(job.Dependencies{Repository: deps.Repository}),
 	}
 }
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestScoringStrategies(t *testing.T) {
+	strategies := map[string]ScoringStrategy{
+		"quadratic":   QuadraticScoring{},
+		"logarithmic": LogarithmicScoring{},
+		"linear":      LinearScoring{},
+	}
+
+	const dMin, dMax, dSolveThreshold = 100, 500, 10
+
+	for name, strategy := range strategies {
+		t.Run(name, func(t *testing.T) {
+			// the first solver gets the maximum points
+			for _, solutions := range []float64{0, 1} {
+				if got := strategy.CalculateScore(dMin, dMax, dSolveThreshold, solutions); got != dMax {
+					t.Errorf("CalculateScore(%v solutions) = %d, want %d", solutions, got, dMax)
+				}
+			}
+
+			// the points reach the minimum at the solve threshold and do not go below it
+			for _, solutions := range []float64{dSolveThreshold + 1, dSolveThreshold + 2, 1000} {
+				if got := strategy.CalculateScore(dMin, dMax, dSolveThreshold, solutions); got != dMin {
+					t.Errorf("CalculateScore(%v solutions) = %d, want %d", solutions, got, dMin)
+				}
+			}
+
+			// the points decay with each solution
+			previous := strategy.CalculateScore(dMin, dMax, dSolveThreshold, 1)
+			for solutions := float64(2); solutions <= dSolveThreshold+1; solutions++ {
+				got := strategy.CalculateScore(dMin, dMax, dSolveThreshold, solutions)
+				if got >= previous {
+					t.Errorf("CalculateScore(%v solutions) = %d, want less than %d", solutions, got, previous)
+				}
+				previous = got
+			}
+
+			// the zero solve threshold does not divide by zero
+			if got := strategy.CalculateScore(dMin, dMax, 0, 5); got != dMin {
+				t.Errorf("CalculateScore(zero threshold) = %d, want %d", got, dMin)
+			}
+		})
+	}
+}
+
+func TestScoringStrategiesValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		strategy  ScoringStrategy
+		solutions float64
+		want      int32
+	}{
+		{name: "quadratic half of the threshold", strategy: QuadraticScoring{}, solutions: 6, want: 400},
+		{name: "quadratic near the threshold", strategy: QuadraticScoring{}, solutions: 10, want: 176},
+		{name: "linear half of the threshold", strategy: LinearScoring{}, solutions: 6, want: 300},
+		{name: "linear near the threshold", strategy: LinearScoring{}, solutions: 10, want: 140},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.strategy.CalculateScore(100, 500, 10, tt.solutions); got != tt.want {
+				t.Errorf("CalculateScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolveOrderBonus(t *testing.T) {
+	tests := []struct {
+		name    string
+		rank    int
+		bonuses []int32
+		want    int32
+	}{
+		{name: "first solve", rank: 1, bonuses: []int32{30, 20, 10}, want: 30},
+		{name: "third solve", rank: 3, bonuses: []int32{30, 20, 10}, want: 10},
+		{name: "after the bonuses", rank: 4, bonuses: []int32{30, 20, 10}, want: 0},
+		{name: "no bonuses", rank: 1, bonuses: nil, want: 0},
+		{name: "zero rank", rank: 0, bonuses: []int32{30}, want: 0},
+		{name: "negative rank", rank: -1, bonuses: []int32{30}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveOrderBonus(tt.rank, tt.bonuses...); got != tt.want {
+				t.Errorf("SolveOrderBonus(%d) = %d, want %d", tt.rank, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,9 @@ package event
 
 import (
 	"context"
+	"errors"
 	"github.com/cybericebox/daemon/internal/model"
-	"github.com/cybericebox/daemon/internal/tools"
-	"github.com/cybericebox/daemon/pkg/worker"
 	"github.com/gofrs/uuid"
-	"github.com/rs/zerolog/log"
 	"slices"
 	"time"
 )
@@ -16,14 +14,22 @@ type (
 		GetEventChallenges(ctx context.Context, eventID uuid.UUID) ([]*model.Challenge, error)
 		GetEventChallengeByID(ctx context.Context, eventID uuid.UUID, challengeID uuid.UUID) (*model.Challenge, error)
 		GetEventChallengeSolvedBy(ctx context.Context, eventID, challengeID uuid.UUID) (*model.ChallengeSoledBy, error)
+		GetFrozenEventChallengeSolvedBy(ctx context.Context, eventID, challengeID, teamID uuid.UUID) (*model.ChallengeSoledBy, error)
 
 		AddExercisesToEvent(ctx context.Context, eventID, categoryID uuid.UUID, exerciseIDs []uuid.UUID) error
 		DeleteEventChallenges(ctx context.Context, eventID uuid.UUID, exerciseID uuid.UUID) error
 		UpdateEventChallengesOrder(ctx context.Context, eventID uuid.UUID, orders []model.Order) error
+		UpdateEventChallengeReleaseTime(ctx context.Context, eventID, challengeID uuid.UUID, releaseTime *time.Time) error
+		UpdateEventChallengeVisibility(ctx context.Context, eventID, challengeID uuid.UUID, visibility int32) error
+		UpdateEventChallengeScoring(ctx context.Context, challenge *model.Challenge) error
+
+		GetEventChallengesScore(ctx context.Context, eventID, teamID uuid.UUID) (map[uuid.UUID]model.ChallengeInfo, error)
+		GetFrozenEventChallengesScore(ctx context.Context, eventID, teamID uuid.UUID) (map[uuid.UUID]model.ChallengeInfo, error)
 
 		DeleteEventTeamsChallenges(ctx context.Context, eventID, exerciseID uuid.UUID) error
 
 		SolveChallenge(ctx context.Context, eventID, teamID, challengeID uuid.UUID, solutionAttempt string) (bool, error)
+		GetTeamChallengeSolutionAttemptTimes(ctx context.Context, eventID, challengeID, teamID uuid.UUID) ([]time.Time, error)
 	}
 )
 
@@ -53,37 +59,70 @@ func (u *EventUseCase) GetEventChallengesInfo(ctx context.Context, eventID uuid.
 		return nil, err
 	}
 
+	hints, err := u.getChallengesHintsInfo(ctx, eventID, team)
+	if err != nil {
+		return nil, err
+	}
+
+	// the points and the bonuses reveal the number of the solves, so they are frozen with the scoreboard
+	var challengesScore map[uuid.UUID]model.ChallengeInfo
+	if u.isScoreboardFrozen(ctx, event) {
+		challengesScore, err = u.service.GetFrozenEventChallengesScore(ctx, eventID, team.ID)
+	} else {
+		challengesScore, err = u.service.GetEventChallengesScore(ctx, eventID, team.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := u.getLockedChallenges(ctx, eventID, team)
+	if err != nil {
+		return nil, err
+	}
+
+	// administrators see the challenges before their release and not visible ones
+	now := time.Now().UTC()
+	administrator := team.ID.IsNil()
+
 	result := make([]*model.CategoryInfo, 0, len(categories))
 	for _, category := range categories {
+		if !administrator && (category.Visibility != model.VisibleChallengeVisibilityType || categoryReleaseTime(event, category).After(now)) {
+			continue
+		}
+
 		challengesInCategory := make([]*model.ChallengeInfo, 0, len(challenges))
 		for _, challenge := range challenges {
 			if challenge.CategoryID == category.ID {
-				// count challenge points
-				points := challenge.Points
-
-				solvedBy, err := u.service.GetEventChallengeSolvedBy(ctx, eventID, challenge.ID)
-				if err != nil {
-					return nil, err
+				if !administrator && (challenge.Visibility != model.VisibleChallengeVisibilityType || challengeReleaseTime(event, category, challenge).After(now)) {
+					continue
 				}
 
-				if event.DynamicScoring {
-					count := len(solvedBy.Teams)
-
-					// calculate points
-					points = tools.CalculateScore(event.DynamicMinScore, event.DynamicMaxScore, event.DynamicSolveThreshold, float64(count))
+				if locked[challenge.ID] && event.HideLockedChallenges {
+					continue
 				}
 
-				// check if challenge is solved by team
-				solved := slices.IndexFunc(solvedBy.Teams, func(t *model.TeamSolvedChallenge) bool {
-					return t.ID == team.ID
-				}) != -1 // -1 if not solved
+				score := challengesScore[challenge.ID]
+
+				if locked[challenge.ID] {
+					challengesInCategory = append(challengesInCategory, &model.ChallengeInfo{
+						ID:     challenge.ID,
+						Name:   challenge.Name,
+						Points: score.Points,
+						Bonus:  score.Bonus,
+						Locked: true,
+					})
+					continue
+				}
 
 				challengesInCategory = append(challengesInCategory, &model.ChallengeInfo{
 					ID:          challenge.ID,
 					Name:        challenge.Name,
 					Description: challenge.Description,
-					Points:      points,
-					Solved:      solved,
+					Points:      score.Points,
+					Bonus:       score.Bonus,
+					Solved:      score.Solved,
+					FirstBlood:  score.FirstBlood,
+					Hints:       hints[challenge.ID],
 				})
 
 			}
@@ -108,27 +147,40 @@ func (u *EventUseCase) AddExercisesToEvent(ctx context.Context, eventID, categor
 		return err
 	}
 
-	u.worker.AddTask(worker.Task{
-		Do: func() {
-			if err = u.service.CreateEventTeamsChallenges(ctx, event.ID); err != nil {
-				log.Error().Err(err).Msg("failed to create event teams challenges")
-			}
-		},
-		CheckIfNeedToDo: func() (bool, *time.Time) {
-			e, err := u.service.GetEventByID(ctx, event.ID)
-			if err != nil {
-				log.Error().Err(err).Msg("failed to get event")
-				return false, nil
-			}
+	// create team challenges for the added exercises, if the event is already started it is done right now
+	if err = u.scheduleEventJob(ctx, model.ReconcileEventChallengesJobType, event.ID, event.StartTime.Add(-time.Minute)); err != nil {
+		return err
+	}
 
-			next := e.StartTime.Add(-time.Minute)
+	return nil
+}
 
-			return e.StartTime.Add(-time.Minute).Before(time.Now().UTC()), &next
-		},
-		TimeToDo: event.StartTime.Add(-time.Minute),
-	})
+func (u *EventUseCase) ReconcileEventChallenges(ctx context.Context, eventID uuid.UUID) error {
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	if laboratoriesReleaseTime(event).Before(time.Now().UTC()) {
+		return model.ErrEventLaboratoriesReleased
+	}
+
+	// reconcile is requested to repair the laboratories, so all challenges are deployed again
+	return u.service.ReconcileEventChallenges(ctx, eventID, true)
+}
+
+func (u *EventUseCase) ReconcileEventTeamChallenges(ctx context.Context, eventID, teamID uuid.UUID) error {
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if laboratoriesReleaseTime(event).Before(time.Now().UTC()) {
+		return model.ErrEventLaboratoriesReleased
+	}
+
+	// reconcile is requested to repair the laboratory, so all challenges are deployed again
+	return u.service.ReconcileEventTeamChallenges(ctx, eventID, teamID, true)
 }
 
 func (u *EventUseCase) DeleteEventChallenge(ctx context.Context, eventID uuid.UUID, challengeID uuid.UUID) error {
@@ -141,7 +193,7 @@ func (u *EventUseCase) DeleteEventChallenge(ctx context.Context, eventID uuid.UU
 		return err
 	}
 
-	if err = u.service.DeleteEventChallenges(ctx, eventID, challengeID); err != nil {
+	if err = u.service.DeleteEventChallenges(ctx, eventID, challenge.ExerciseID); err != nil {
 		return err
 	}
 
@@ -153,7 +205,18 @@ func (u *EventUseCase) UpdateEventChallengesOrder(ctx context.Context, eventID u
 }
 
 func (u *EventUseCase) GetTeamsSolvedChallenge(ctx context.Context, eventID, challengeID uuid.UUID) ([]*model.TeamSolvedChallenge, error) {
-	solvedBy, err := u.service.GetEventChallengeSolvedBy(ctx, eventID, challengeID)
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var solvedBy *model.ChallengeSoledBy
+	if u.isScoreboardFrozen(ctx, event) {
+		// participants see the real solve of their team
+		solvedBy, err = u.service.GetFrozenEventChallengeSolvedBy(ctx, eventID, challengeID, u.getViewerTeamID(ctx, eventID))
+	} else {
+		solvedBy, err = u.service.GetEventChallengeSolvedBy(ctx, eventID, challengeID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -161,28 +224,160 @@ func (u *EventUseCase) GetTeamsSolvedChallenge(ctx context.Context, eventID, cha
 	return solvedBy.Teams, nil
 }
 
-func (u *EventUseCase) SolveChallenge(ctx context.Context, eventID, challengeID uuid.UUID, solution string) (bool, error) {
+func (u *EventUseCase) UpdateEventChallengeVisibility(ctx context.Context, eventID, challengeID uuid.UUID, visibility int32) error {
+	if visibility < model.DraftChallengeVisibilityType || visibility > model.ArchivedChallengeVisibilityType {
+		return model.ErrChallengeVisibilityInvalid
+	}
+
+	return u.service.UpdateEventChallengeVisibility(ctx, eventID, challengeID, visibility)
+}
+
+func (u *EventUseCase) UpdateEventChallengeScoring(ctx context.Context, challenge *model.Challenge) error {
+	current, err := u.service.GetEventChallengeByID(ctx, challenge.EventID, challenge.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := u.GetEvent(ctx, challenge.EventID)
+	if err != nil {
+		return err
+	}
+
+	// the overrides are validated together with the event values they do not replace
+	dMin, dMax := event.DynamicMinScore, event.DynamicMaxScore
+	if challenge.DynamicMinScore != nil {
+		dMin = *challenge.DynamicMinScore
+	}
+	if challenge.DynamicMaxScore != nil {
+		dMax = *challenge.DynamicMaxScore
+	}
+
+	if dMin < 0 || dMin > dMax || (challenge.DynamicSolveThreshold != nil && *challenge.DynamicSolveThreshold < 1) {
+		return model.ErrChallengeScoringInvalid
+	}
+
+	current.DynamicMinScore = challenge.DynamicMinScore
+	current.DynamicMaxScore = challenge.DynamicMaxScore
+	current.DynamicSolveThreshold = challenge.DynamicSolveThreshold
+
+	return u.service.UpdateEventChallengeScoring(ctx, current)
+}
+
+// checkChallengeAvailable checks if participants can work on the challenge,
+// the challenge and its category have to be visible and released
+func (u *EventUseCase) checkChallengeAvailable(ctx context.Context, event *model.Event, challengeID uuid.UUID) error {
+	challenge, err := u.service.GetEventChallengeByID(ctx, event.ID, challengeID)
+	if err != nil {
+		return err
+	}
+
+	categories, err := u.service.GetEventCategories(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	var category *model.ChallengeCategory
+	for _, c := range categories {
+		if c.ID == challenge.CategoryID {
+			category = c
+		}
+	}
+
+	// hidden challenges are not shown to participants, so they do not exist for them
+	if challenge.Visibility != model.VisibleChallengeVisibilityType ||
+		(category != nil && category.Visibility != model.VisibleChallengeVisibilityType) {
+		return model.ErrChallengeNotFound
+	}
+
+	if challengeReleaseTime(event, category, challenge).After(time.Now().UTC()) {
+		return model.ErrChallengeNotReleased
+	}
+
+	return nil
+}
+
+func (u *EventUseCase) SolveChallenge(ctx context.Context, eventID, challengeID uuid.UUID, solution string) (*model.SolutionAttemptResult, error) {
 	// check if user has team in event
 	team, err := u.GetSelfTeam(ctx, eventID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	// check if allowed to solve challenge
 	// if event is not started, or ended, or paused
 	event, err := u.GetEvent(ctx, eventID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	if event.StartTime.After(time.Now().UTC()) || event.FinishTime.Before(time.Now().UTC()) {
-		return false, model.ErrSolutionAttemptNotAllowed
+		return nil, model.ErrSolutionAttemptNotAllowed
+	}
+
+	if team.Disqualified {
+		return nil, model.ErrTeamDisqualified
+	}
+
+	if err = u.checkChallengeAvailable(ctx, event, challengeID); err != nil {
+		return nil, err
+	}
+
+	// the team caught on sharing flags of the challenge can not solve it anymore
+	if event.FlagSharingAction == model.RejectFlagSharingAction {
+		shared, err := u.service.TeamHasCheatingIncident(ctx, eventID, challengeID, team.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if shared {
+			return nil, model.ErrFlagSharingDetected
+		}
+	}
+
+	locked, err := u.isChallengeLocked(ctx, eventID, challengeID, team)
+	if err != nil {
+		return nil, err
+	}
+
+	if locked {
+		return nil, model.ErrChallengeLocked
+	}
+
+	// the repeated correct solution would change the solve order and the dynamic points
+	solvedIDs, err := u.service.GetTeamSolvedChallengeIDs(ctx, eventID, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(solvedIDs, challengeID) {
+		return nil, model.ErrChallengeAlreadySolved
+	}
+
+	// check if the team is allowed to make one more attempt
+	attempts, err := u.service.GetTeamChallengeSolutionAttemptTimes(ctx, eventID, challengeID, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if state := solutionAttemptsState(event, attempts, now); state.RemainingAttempts == 0 {
+		if state.RetryAfter != nil {
+			return state, model.ErrSolutionAttemptsThrottled
+		}
+		return state, model.ErrSolutionAttemptsExhausted
 	}
 
 	solved, err := u.service.SolveChallenge(ctx, eventID, team.ID, challengeID, solution)
+	if errors.Is(err, model.ErrFlagSharingDetected) {
+		err = u.handleFlagSharing(ctx, event, team.ID)
+	}
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return solved, nil
+	// the state after the current attempt
+	result := solutionAttemptsState(event, append([]time.Time{now}, attempts...), now)
+	result.Solved = solved
+
+	return result, nil
 }
@@ -13,6 +13,7 @@ type (
 		UpdateEventCategory(ctx context.Context, category *model.ChallengeCategory) error
 		DeleteEventCategory(ctx context.Context, eventID uuid.UUID, categoryID uuid.UUID) error
 		UpdateEventCategoriesOrder(ctx context.Context, eventID uuid.UUID, orders []model.Order) error
+		UpdateEventCategoryVisibility(ctx context.Context, eventID, categoryID uuid.UUID, visibility int32) error
 	}
 )
 
@@ -25,7 +26,17 @@ func (u *EventUseCase) CreateEventCategory(ctx context.Context, category *model.
 }
 
 func (u *EventUseCase) UpdateEventCategory(ctx context.Context, category *model.ChallengeCategory) error {
-	return u.service.UpdateEventCategory(ctx, category)
+	if err := u.service.UpdateEventCategory(ctx, category); err != nil {
+		return err
+	}
+
+	event, err := u.GetEvent(ctx, category.EventID)
+	if err != nil {
+		return err
+	}
+
+	// the category release time could be changed
+	return u.scheduleChallengesRelease(ctx, event)
 }
 
 func (u *EventUseCase) DeleteEventCategory(ctx context.Context, eventID uuid.UUID, categoryID uuid.UUID) error {
@@ -35,3 +46,11 @@ func (u *EventUseCase) DeleteEventCategory(ctx context.Context, eventID uuid.UUI
 func (u *EventUseCase) UpdateEventCategoriesOrder(ctx context.Context, eventID uuid.UUID, orders []model.Order) error {
 	return u.service.UpdateEventCategoriesOrder(ctx, eventID, orders)
 }
+
+func (u *EventUseCase) UpdateEventCategoryVisibility(ctx context.Context, eventID, categoryID uuid.UUID, visibility int32) error {
+	if visibility < model.DraftChallengeVisibilityType || visibility > model.ArchivedChallengeVisibilityType {
+		return model.ErrChallengeVisibilityInvalid
+	}
+
+	return u.service.UpdateEventCategoryVisibility(ctx, eventID, categoryID, visibility)
+}
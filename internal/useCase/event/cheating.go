@@ -0,0 +1,39 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	ICheatingService interface {
+		GetEventCheatingIncidents(ctx context.Context, eventID uuid.UUID) ([]*model.CheatingIncident, error)
+		TeamHasCheatingIncident(ctx context.Context, eventID, challengeID, teamID uuid.UUID) (bool, error)
+		UpdateTeamDisqualified(ctx context.Context, eventID, teamID uuid.UUID, disqualified bool) error
+	}
+)
+
+func (u *EventUseCase) GetEventCheatingIncidents(ctx context.Context, eventID uuid.UUID) ([]*model.CheatingIncident, error) {
+	return u.service.GetEventCheatingIncidents(ctx, eventID)
+}
+
+func (u *EventUseCase) UpdateTeamDisqualified(ctx context.Context, eventID, teamID uuid.UUID, disqualified bool) error {
+	return u.service.UpdateTeamDisqualified(ctx, eventID, teamID, disqualified)
+}
+
+// handleFlagSharing applies the event flag sharing action to the team, which submitted the flag of another team,
+// the incident is already recorded, so the attempt is treated as an incorrect one if the action is log only
+func (u *EventUseCase) handleFlagSharing(ctx context.Context, event *model.Event, teamID uuid.UUID) error {
+	switch event.FlagSharingAction {
+	case model.RejectFlagSharingAction:
+		return model.ErrFlagSharingDetected
+	case model.DisqualifyFlagSharingAction:
+		if err := u.service.UpdateTeamDisqualified(ctx, event.ID, teamID, true); err != nil {
+			return err
+		}
+		return model.ErrTeamDisqualified
+	default:
+		return nil
+	}
+}
@@ -4,9 +4,8 @@ import (
 	"context"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/internal/tools"
-	"github.com/cybericebox/daemon/pkg/worker"
 	"github.com/gofrs/uuid"
-	"github.com/rs/zerolog/log"
+	"github.com/hashicorp/go-multierror"
 	"time"
 )
 
@@ -21,6 +20,7 @@ type (
 
 		GetParticipantJoinEventStatus(ctx context.Context, eventID, userID uuid.UUID) (int32, error)
 		CreateJoinEventRequest(ctx context.Context, eventID, userID uuid.UUID, status int32) error
+		DeleteJoinEventRequest(ctx context.Context, eventID, userID uuid.UUID) error
 
 		GetUserByID(ctx context.Context, userID uuid.UUID) (*model.User, error)
 	}
@@ -36,6 +36,16 @@ func (u *EventUseCase) GetEventInfo(ctx context.Context, eventID uuid.UUID) (*mo
 		return nil, err
 	}
 
+	hasAccess, err := u.hasEventAccess(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	// private event is hidden from the users without access
+	if !hasAccess {
+		return nil, model.ErrEventNotFound
+	}
+
 	return &model.EventInfo{
 		Type:                   event.Type,
 		Participation:          event.Participation,
@@ -49,10 +59,26 @@ func (u *EventUseCase) GetEventInfo(ctx context.Context, eventID uuid.UUID) (*mo
 		ParticipantsVisibility: event.ParticipantsVisibility,
 		StartTime:              event.StartTime,
 		FinishTime:             event.FinishTime,
+		ScoreboardFreezeTime:   event.ScoreboardFreezeTime,
+		ScoreboardRevealed:     event.ScoreboardRevealed,
 	}, nil
 }
 
 func (u *EventUseCase) UpdateEvent(ctx context.Context, event *model.Event) error {
+	if event.ScoringStrategy < model.QuadraticScoringStrategy || event.ScoringStrategy > model.LinearScoringStrategy {
+		return model.ErrScoringStrategyInvalid
+	}
+
+	// scoreboard can be frozen only during the event
+	if event.ScoreboardFreezeTime != nil && (event.ScoreboardFreezeTime.Before(event.StartTime) || event.ScoreboardFreezeTime.After(event.FinishTime)) {
+		return model.ErrScoreboardFreezeInvalid
+	}
+
+	// the attempts limit is counted within the window, so it never triggers without the window
+	if event.SolutionAttemptsLimit > 0 && event.SolutionAttemptsWindow <= 0 {
+		return model.ErrSolutionAttemptsLimitInvalid
+	}
+
 	// check if event time is changed
 	// get old event
 	oldEvent, err := u.GetEvent(ctx, event.ID)
@@ -60,36 +86,51 @@ func (u *EventUseCase) UpdateEvent(ctx context.Context, event *model.Event) erro
 		return err
 	}
 
-	// if any event time is changed, we need update workers
-	// if event start time is changed we need to update start event worker
+	if err = u.service.UpdateEvent(ctx, event); err != nil {
+		return err
+	}
+
+	// if any event time is changed, we need to reschedule event jobs
+	// if event start time is changed we need to reschedule the creation of the event team challenges
 	if oldEvent.StartTime != event.StartTime {
-		// update start event worker
-		// task to create event team challenges on event start
-		u.worker.AddTask(worker.Task{
-			Do: func() {
-				if err = u.service.CreateEventTeamsChallenges(ctx, event.ID); err != nil {
-					log.Error().Err(err).Msg("failed to create event teams challenges")
-				}
-			},
-			CheckIfNeedToDo: func() (bool, *time.Time) {
-				e, err := u.service.GetEventByID(ctx, event.ID)
-				if err != nil {
-					log.Error().Err(err).Msg("failed to get event")
-					return false, nil
-				}
+		if err = u.scheduleEventJob(ctx, model.ReconcileEventChallengesJobType, event.ID, event.StartTime.Add(-time.Minute)); err != nil {
+			return err
+		}
+	}
 
-				next := e.StartTime.Add(-time.Minute)
+	// if event finish or withdraw time is changed we need to reschedule the release of the event resources
+	if laboratoriesReleaseTime(oldEvent) != laboratoriesReleaseTime(event) {
+		if err = u.scheduleEventJob(ctx, model.ReleaseEventResourcesJobType, event.ID, laboratoriesReleaseTime(event)); err != nil {
+			return err
+		}
+	}
 
-				return e.StartTime.Add(-time.Minute).Before(time.Now().UTC()), &next
-			},
-			TimeToDo: event.StartTime.Add(-time.Minute),
-		})
+	// if event places are added, waitlisted participants take them
+	if eventPlaces(oldEvent) != eventPlaces(event) {
+		// get updated event, because not all fields can be updated
+		updatedEvent, err := u.GetEvent(ctx, event.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = u.promoteWaitlistedParticipants(ctx, updatedEvent); err != nil {
+			return err
+		}
 	}
 
-	return u.service.UpdateEvent(ctx, event)
+	return nil
 }
 
 func (u *EventUseCase) DeleteEvent(ctx context.Context, eventID uuid.UUID) error {
+	// release event laboratories and vpn clients, they are not bound to the event records
+	if err := u.service.ReleaseEventResources(ctx, eventID); err != nil {
+		return err
+	}
+
+	if err := u.cancelEventJobs(ctx, eventID); err != nil {
+		return err
+	}
+
 	return u.service.DeleteEvent(ctx, eventID)
 }
 
@@ -123,7 +164,8 @@ func (u *EventUseCase) GetJoinEventStatus(ctx context.Context, eventID uuid.UUID
 	return status, nil
 }
 
-func (u *EventUseCase) JoinEvent(ctx context.Context, eventID uuid.UUID) error {
+// JoinEvent creates the join request of the current user, the invitation code is required for the private event without the user invitation
+func (u *EventUseCase) JoinEvent(ctx context.Context, eventID uuid.UUID, invitationCode string) error {
 	// get event
 	event, err := u.service.GetEventByID(ctx, eventID)
 	if err != nil {
@@ -150,6 +192,22 @@ func (u *EventUseCase) JoinEvent(ctx context.Context, eventID uuid.UUID) error {
 		return model.ErrEventAlreadyJoined
 	}
 
+	hasAccess, err := u.hasEventAccess(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if !hasAccess {
+		if invitationCode == "" {
+			return model.ErrEventNotFound
+		}
+
+		// the code is checked before the join request is prepared, its use is counted on the join
+		if err = u.service.CheckEventInvitationCode(ctx, eventID, invitationCode); err != nil {
+			return err
+		}
+	}
+
 	// get current userID
 	userID, err := tools.GetCurrentUserIDFromContext(ctx)
 	if err != nil {
@@ -163,22 +221,61 @@ func (u *EventUseCase) JoinEvent(ctx context.Context, eventID uuid.UUID) error {
 		participationStatus = model.ApprovedParticipationStatus
 	}
 
+	full, err := u.isEventFull(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	// if all event places are taken, user waits for the free place
+	if full {
+		participationStatus = model.WaitlistedParticipationStatus
+	}
+
+	// the use of the code is counted for the user, who has no other access,
+	// the waitlisted user reserves the use, so the code does not admit more users than its uses on the promotion
+	if hasAccess {
+		return u.joinEvent(ctx, event, userID, participationStatus)
+	}
+
+	codeID, err := u.service.UseEventInvitationCode(ctx, eventID, invitationCode)
+	if err != nil {
+		return err
+	}
+
+	// the use is returned to the code, if the user is not joined
+	if err = u.joinEvent(ctx, event, userID, participationStatus); err != nil {
+		if releaseErr := u.service.ReleaseEventInvitationCodeUse(ctx, codeID); releaseErr != nil {
+			return multierror.Append(err, releaseErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// joinEvent creates the join request of the user and the team of the approved individual participant
+func (u *EventUseCase) joinEvent(ctx context.Context, event *model.Event, userID uuid.UUID, participationStatus int32) error {
 	// create join event request
-	if err = u.service.CreateJoinEventRequest(ctx, eventID, userID, participationStatus); err != nil {
+	if err := u.service.CreateJoinEventRequest(ctx, event.ID, userID, participationStatus); err != nil {
 		return err
 	}
 
 	// if registration is open, create participant for user
-	if event.Registration == model.OpenRegistrationType {
+	if participationStatus == model.ApprovedParticipationStatus {
 		// if event participation is individual, create team for user with name as user`s name
 		if event.Participation == model.IndividualParticipationType {
 			// get user
 			user, err := u.service.GetUserByID(ctx, userID)
-			if err != nil {
-				return err
+			if err == nil {
+				// create team for user with name as user`s name
+				err = u.CreateTeam(ctx, event.ID, user.Name)
 			}
-			// create team for user with name as user`s name
-			if err = u.CreateTeam(ctx, eventID, user.Name); err != nil {
+
+			// the join request is deleted, so the user can join the event again
+			if err != nil {
+				if deleteErr := u.service.DeleteJoinEventRequest(ctx, event.ID, userID); deleteErr != nil {
+					return multierror.Append(err, deleteErr)
+				}
 				return err
 			}
 		}
@@ -212,6 +309,12 @@ func (u *EventUseCase) ShouldProxyEvent(ctx context.Context, eventTag string) bo
 		}
 	}
 
+	// private event is proxied only for the users, who have access to it
+	hasAccess, err := u.hasEventAccess(ctx, event)
+	if err != nil || !hasAccess {
+		return false
+	}
+
 	// if event is published and not withdrawn
 	if time.Now().UTC().After(event.PublishTime) && time.Now().UTC().Before(event.WithdrawTime) {
 		return true
@@ -0,0 +1,145 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type (
+	IHintService interface {
+		GetEventChallengesHints(ctx context.Context, eventID uuid.UUID) ([]*model.ChallengeHint, error)
+		GetTeamUnlockedHintIDs(ctx context.Context, eventID, teamID uuid.UUID) ([]uuid.UUID, error)
+		UnlockHint(ctx context.Context, eventID, teamID uuid.UUID, hint *model.ChallengeHint) error
+	}
+)
+
+// getChallengesHintsInfo returns the hints of the event challenges grouped by challenge in their order,
+// the content is returned only for the hints unlocked by the team
+func (u *EventUseCase) getChallengesHintsInfo(ctx context.Context, eventID uuid.UUID, team *model.Team) (map[uuid.UUID][]*model.HintInfo, error) {
+	hints, err := u.service.GetEventChallengesHints(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked := make(map[uuid.UUID]bool)
+	// administrators do not have a real team and see all hints
+	if !team.ID.IsNil() {
+		unlockedIDs, err := u.service.GetTeamUnlockedHintIDs(ctx, eventID, team.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range unlockedIDs {
+			unlocked[id] = true
+		}
+	}
+
+	result := make(map[uuid.UUID][]*model.HintInfo)
+	for _, hint := range hints {
+		info := &model.HintInfo{
+			ID:       hint.ID,
+			Cost:     hint.Cost,
+			Unlocked: team.ID.IsNil() || unlocked[hint.ID],
+		}
+
+		if info.Unlocked {
+			info.Content = hint.Content
+		}
+
+		result[hint.ChallengeID] = append(result[hint.ChallengeID], info)
+	}
+
+	return result, nil
+}
+
+func (u *EventUseCase) UnlockChallengeHint(ctx context.Context, eventID, challengeID, hintID uuid.UUID) (*model.HintInfo, error) {
+	// check if user has team in event
+	team, err := u.GetSelfTeam(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// administrators see all hints without unlocking
+	if team.ID.IsNil() {
+		return nil, model.ErrHintUnlockNotAllowed
+	}
+
+	if team.Disqualified {
+		return nil, model.ErrTeamDisqualified
+	}
+
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.StartTime.After(time.Now().UTC()) || event.FinishTime.Before(time.Now().UTC()) {
+		return nil, model.ErrHintUnlockNotAllowed
+	}
+
+	if err = u.checkChallengeAvailable(ctx, event, challengeID); err != nil {
+		return nil, err
+	}
+
+	locked, err := u.isChallengeLocked(ctx, eventID, challengeID, team)
+	if err != nil {
+		return nil, err
+	}
+
+	if locked {
+		return nil, model.ErrChallengeLocked
+	}
+
+	hints, err := u.service.GetEventChallengesHints(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockedIDs, err := u.service.GetTeamUnlockedHintIDs(ctx, eventID, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked := make(map[uuid.UUID]bool)
+	for _, id := range unlockedIDs {
+		unlocked[id] = true
+	}
+
+	// hints are ordered by challenge and order index
+	challengeHints := make([]*model.ChallengeHint, 0)
+	for _, hint := range hints {
+		if hint.ChallengeID == challengeID {
+			challengeHints = append(challengeHints, hint)
+		}
+	}
+
+	for index, hint := range challengeHints {
+		if hint.ID != hintID {
+			continue
+		}
+
+		if !unlocked[hint.ID] {
+			// all previous hints of the challenge have to be unlocked
+			for _, previous := range challengeHints[:index] {
+				if !unlocked[previous.ID] {
+					return nil, model.ErrHintUnlockOrder
+				}
+			}
+
+			if err = u.service.UnlockHint(ctx, eventID, team.ID, hint); err != nil {
+				return nil, err
+			}
+		}
+
+		return &model.HintInfo{
+			ID:       hint.ID,
+			Cost:     hint.Cost,
+			Unlocked: true,
+			Content:  hint.Content,
+		}, nil
+	}
+
+	return nil, model.ErrHintNotFound
+}
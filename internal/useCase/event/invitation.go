@@ -0,0 +1,157 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+type (
+	IInvitationService interface {
+		GetEventInvitationCodes(ctx context.Context, eventID uuid.UUID) ([]*model.InvitationCode, error)
+		CreateEventInvitationCode(ctx context.Context, code *model.InvitationCode) (*model.InvitationCode, error)
+		DeleteEventInvitationCode(ctx context.Context, eventID, codeID uuid.UUID) error
+		UseEventInvitationCode(ctx context.Context, eventID uuid.UUID, code string) (uuid.UUID, error)
+		CheckEventInvitationCode(ctx context.Context, eventID uuid.UUID, code string) error
+		ReleaseEventInvitationCodeUse(ctx context.Context, codeID uuid.UUID) error
+
+		GetEventInvitations(ctx context.Context, eventID uuid.UUID) ([]*model.Invitation, error)
+		CreateEventInvitations(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error
+		DeleteEventInvitation(ctx context.Context, eventID, userID uuid.UUID) error
+		IsUserInvitedToEvent(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+		GetUserInvitedEventIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	}
+)
+
+func (u *EventUseCase) GetEventInvitationCodes(ctx context.Context, eventID uuid.UUID) ([]*model.InvitationCode, error) {
+	return u.service.GetEventInvitationCodes(ctx, eventID)
+}
+
+func (u *EventUseCase) CreateEventInvitationCode(ctx context.Context, eventID uuid.UUID, maxUses int32, expiresAt *time.Time) (*model.InvitationCode, error) {
+	return u.service.CreateEventInvitationCode(ctx, &model.InvitationCode{
+		EventID:   eventID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+	})
+}
+
+func (u *EventUseCase) DeleteEventInvitationCode(ctx context.Context, eventID, codeID uuid.UUID) error {
+	return u.service.DeleteEventInvitationCode(ctx, eventID, codeID)
+}
+
+func (u *EventUseCase) GetEventInvitations(ctx context.Context, eventID uuid.UUID) ([]*model.Invitation, error) {
+	return u.service.GetEventInvitations(ctx, eventID)
+}
+
+func (u *EventUseCase) InviteUsersToEvent(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error {
+	return u.service.CreateEventInvitations(ctx, eventID, userIDs...)
+}
+
+func (u *EventUseCase) DeleteEventInvitation(ctx context.Context, eventID, userID uuid.UUID) error {
+	return u.service.DeleteEventInvitation(ctx, eventID, userID)
+}
+
+// GetSelfInvitations returns the not withdrawn events, which the current user is invited to
+func (u *EventUseCase) GetSelfInvitations(ctx context.Context) ([]*model.EventInfo, error) {
+	// get current userID
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs, err := u.service.GetUserInvitedEventIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsInfo := make([]*model.EventInfo, 0, len(eventIDs))
+	for _, eventID := range eventIDs {
+		event, err := u.GetEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+
+		if event.WithdrawTime.Before(time.Now().UTC()) {
+			continue
+		}
+
+		eventsInfo = append(eventsInfo, &model.EventInfo{
+			Type:                   event.Type,
+			Participation:          event.Participation,
+			Tag:                    event.Tag,
+			Name:                   event.Name,
+			Description:            event.Description,
+			Rules:                  event.Rules,
+			Picture:                event.Picture,
+			Registration:           event.Registration,
+			ScoreboardAvailability: event.ScoreboardAvailability,
+			ParticipantsVisibility: event.ParticipantsVisibility,
+			StartTime:              event.StartTime,
+			FinishTime:             event.FinishTime,
+		})
+	}
+
+	return eventsInfo, nil
+}
+
+func (u *EventUseCase) ProtectEvent(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	event, err := u.service.GetEventByID(ctx, eventID)
+	if err != nil {
+		return true, err
+	}
+
+	// private event is available only for the identified users
+	return event.Availability == model.PrivateAvailabilityType, nil
+}
+
+// EventFrontendNeedsProtection returns true if the event frontend is proxied for the private event
+func (u *EventUseCase) EventFrontendNeedsProtection(ctx context.Context, subdomain string) bool {
+	if subdomain == config.MainSubdomain || subdomain == config.AdminSubdomain {
+		return false
+	}
+
+	event, err := u.service.GetEventByTag(ctx, subdomain)
+	if err != nil {
+		return false
+	}
+
+	return event.Availability == model.PrivateAvailabilityType
+}
+
+// hasEventAccess returns true if the event is public or the current user is an administrator, an approved participant or invited to the event
+func (u *EventUseCase) hasEventAccess(ctx context.Context, event *model.Event) (bool, error) {
+	if event.Availability == model.PublicAvailabilityType {
+		return true, nil
+	}
+
+	// user is not identified for not protected request
+	userRole, err := tools.GetCurrentUserRoleFromContext(ctx)
+	if err != nil {
+		return false, nil
+	}
+
+	if userRole == model.AdministratorRole {
+		return true, nil
+	}
+
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return false, nil
+	}
+
+	// approved participant, who joined the event by the invitation code, keeps access,
+	// the pending, waitlisted and rejected participants get it only on the approval
+	status, err := u.service.GetParticipantJoinEventStatus(ctx, event.ID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if status == model.ApprovedParticipationStatus {
+		return true, nil
+	}
+
+	return u.service.IsUserInvitedToEvent(ctx, event.ID, userID)
+}
@@ -0,0 +1,231 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/pkg/worker"
+	"github.com/gofrs/uuid"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+type (
+	IJobService interface {
+		ScheduleJob(ctx context.Context, job *model.Job) error
+		GetJob(ctx context.Context, key string) (*model.Job, error)
+		GetPendingJobs(ctx context.Context) ([]*model.Job, error)
+		CompleteJob(ctx context.Context, job *model.Job) error
+		FailJob(ctx context.Context, job *model.Job, jobErr error) error
+		CancelJob(ctx context.Context, key string) error
+	}
+)
+
+// RestoreJobs queues the pending jobs, which were scheduled before the restart
+func (u *EventUseCase) RestoreJobs(ctx context.Context) error {
+	jobs, err := u.service.GetPendingJobs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		u.worker.AddTask(u.newJobTask(job.Key, job.RunAt))
+	}
+
+	return nil
+}
+
+// ScheduleEventsJobs schedules the missing jobs of the events,
+// the resources of the events, which release time is passed without the job, are released right now
+func (u *EventUseCase) ScheduleEventsJobs(ctx context.Context) error {
+	// get all events
+	events, err := u.GetEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for _, event := range events {
+		jobs := map[int32]time.Time{
+			model.ReconcileEventChallengesJobType: event.StartTime.Add(-time.Minute),
+			model.ReleaseEventResourcesJobType:    laboratoriesReleaseTime(event),
+		}
+
+		// challenges of the finished event are not deployed anymore
+		if laboratoriesReleaseTime(event).Before(now) {
+			jobs = map[int32]time.Time{
+				model.ReleaseEventResourcesJobType: now,
+			}
+		}
+
+		for jobType, runAt := range jobs {
+			_, err = u.service.GetJob(ctx, eventJobKey(jobType, event.ID))
+			if err == nil {
+				continue
+			}
+
+			if !errors.Is(err, model.ErrJobNotFound) {
+				return err
+			}
+
+			if err = u.scheduleEventJob(ctx, jobType, event.ID, runAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// scheduleEventJob persists the event job and queues it, the job replaces the previous one of the same type for the event
+func (u *EventUseCase) scheduleEventJob(ctx context.Context, jobType int32, eventID uuid.UUID, runAt time.Time) error {
+	payload, err := json.Marshal(model.EventJobPayload{EventID: eventID})
+	if err != nil {
+		return err
+	}
+
+	job := &model.Job{
+		Type:    jobType,
+		Key:     eventJobKey(jobType, eventID),
+		Payload: payload,
+		RunAt:   runAt,
+	}
+
+	if err = u.service.ScheduleJob(ctx, job); err != nil {
+		return err
+	}
+
+	u.worker.AddTask(u.newJobTask(job.Key, job.RunAt))
+
+	return nil
+}
+
+func (u *EventUseCase) cancelEventJobs(ctx context.Context, eventID uuid.UUID) error {
+	for _, jobType := range []int32{model.ReconcileEventChallengesJobType, model.ReleaseEventResourcesJobType} {
+		key := eventJobKey(jobType, eventID)
+
+		if err := u.service.CancelJob(ctx, key); err != nil {
+			return err
+		}
+
+		u.worker.CancelTask(key)
+	}
+
+	return nil
+}
+
+func (u *EventUseCase) newJobTask(key string, runAt time.Time) worker.Task {
+	return worker.Task{
+		Key: key,
+		Do: func() {
+			u.runJob(key)
+		},
+		CheckIfNeedToDo: func() (bool, *time.Time) {
+			job, err := u.service.GetJob(context.Background(), key)
+			if err != nil {
+				if !errors.Is(err, model.ErrJobNotFound) {
+					log.Error().Err(err).Str("job", key).Msg("failed to get job")
+				}
+				return false, nil
+			}
+
+			// failed jobs are kept only for inspection
+			if job.Status != model.PendingJobStatus {
+				return false, nil
+			}
+
+			return !job.RunAt.After(time.Now().UTC()), &job.RunAt
+		},
+		TimeToDo: runAt,
+	}
+}
+
+func (u *EventUseCase) runJob(key string) {
+	// the same job can be queued several times, so it has to be run only once at a time
+	u.m.Lock()
+	if u.runningJobs[key] {
+		u.m.Unlock()
+		return
+	}
+	u.runningJobs[key] = true
+	u.m.Unlock()
+
+	defer func() {
+		u.m.Lock()
+		delete(u.runningJobs, key)
+		u.m.Unlock()
+	}()
+
+	// job context is detached from the request, which scheduled the job
+	ctx, cancel := context.WithTimeout(context.Background(), config.JobTimeout)
+	defer cancel()
+
+	job, err := u.service.GetJob(ctx, key)
+	if err != nil {
+		if !errors.Is(err, model.ErrJobNotFound) {
+			log.Error().Err(err).Str("job", key).Msg("failed to get job")
+		}
+		return
+	}
+
+	// job is rescheduled or failed while it was waiting in the queue
+	if job.Status != model.PendingJobStatus || job.RunAt.After(time.Now().UTC()) {
+		return
+	}
+
+	if err = u.doJob(ctx, job); err != nil {
+		log.Error().Err(err).Str("job", key).Int32("attempt", job.Attempts+1).Msg("failed to do job")
+
+		if err = u.service.FailJob(ctx, job, err); err != nil {
+			log.Error().Err(err).Str("job", key).Msg("failed to save job attempt")
+			return
+		}
+
+		// retry the job later
+		if job.Status == model.PendingJobStatus {
+			u.worker.AddTask(u.newJobTask(job.Key, job.RunAt))
+		}
+		return
+	}
+
+	if err = u.service.CompleteJob(ctx, job); err != nil {
+		log.Error().Err(err).Str("job", key).Msg("failed to complete job")
+	}
+}
+
+func (u *EventUseCase) doJob(ctx context.Context, job *model.Job) error {
+	var payload model.EventJobPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+
+	event, err := u.service.GetEventByID(ctx, payload.EventID)
+	if err != nil {
+		return err
+	}
+
+	switch job.Type {
+	case model.ReconcileEventChallengesJobType:
+		// laboratories are already released, there is nothing to create
+		if laboratoriesReleaseTime(event).Before(time.Now().UTC()) {
+			return nil
+		}
+		if err = u.service.ReconcileEventChallenges(ctx, event.ID, false); err != nil {
+			return err
+		}
+		// the job is rescheduled for the next release wave, so it is not deleted on completion
+		return u.scheduleNextChallengesRelease(ctx, event)
+	case model.ReleaseEventResourcesJobType:
+		return u.service.ReleaseEventResources(ctx, event.ID)
+	default:
+		return model.ErrUnknownJobType
+	}
+}
+
+func eventJobKey(jobType int32, eventID uuid.UUID) string {
+	return fmt.Sprintf("event-%d-%s", jobType, eventID.String())
+}
@@ -0,0 +1,261 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/cybericebox/daemon/internal/tools"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-multierror"
+)
+
+type (
+	IParticipantService interface {
+		GetEventParticipants(ctx context.Context, eventID uuid.UUID) ([]*model.Participant, error)
+		UpdateParticipantStatus(ctx context.Context, eventID, userID uuid.UUID, status int32) error
+	}
+)
+
+func (u *EventUseCase) GetEventParticipants(ctx context.Context, eventID uuid.UUID) ([]*model.Participant, error) {
+	return u.service.GetEventParticipants(ctx, eventID)
+}
+
+// GetEventParticipantsInfo returns the teams with their approved members according to the event participants visibility
+func (u *EventUseCase) GetEventParticipantsInfo(ctx context.Context, eventID uuid.UUID) ([]*model.TeamParticipantsInfo, error) {
+	event, err := u.service.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// user role is not set for not protected request
+	userRole, _ := tools.GetCurrentUserRoleFromContext(ctx)
+	isAdministrator := userRole == model.AdministratorRole
+
+	if !isAdministrator {
+		switch event.ParticipantsVisibility {
+		case model.PublicParticipantsVisibilityType:
+		// return private participants only if the user is a participant
+		case model.PrivateParticipantsVisibilityType:
+			if _, err = u.GetSelfTeam(ctx, eventID); err != nil {
+				return nil, model.ErrParticipantsNotVisible
+			}
+		default:
+			return nil, model.ErrParticipantsNotVisible
+		}
+	}
+
+	teams, err := u.GetEventTeamsInfo(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := u.service.GetEventParticipants(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[uuid.UUID][]*model.ParticipantInfo)
+	for _, participant := range participants {
+		if participant.ApprovalStatus != model.ApprovedParticipationStatus || !participant.TeamID.Valid {
+			continue
+		}
+
+		member := &model.ParticipantInfo{
+			Name: participant.Name,
+		}
+
+		if isAdministrator {
+			member.Email = participant.Email
+		}
+
+		members[participant.TeamID.UUID] = append(members[participant.TeamID.UUID], member)
+	}
+
+	result := make([]*model.TeamParticipantsInfo, 0, len(teams))
+	for _, team := range teams {
+		result = append(result, &model.TeamParticipantsInfo{
+			ID:      team.ID,
+			Name:    team.Name,
+			Members: members[team.ID],
+		})
+	}
+
+	return result, nil
+}
+
+func (u *EventUseCase) ProtectParticipants(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	event, err := u.service.GetEventByID(ctx, eventID)
+	if err != nil {
+		return true, err
+	}
+
+	// administrators are identified to get the participants emails
+	if subdomain, err := tools.GetSubdomainFromContext(ctx); err == nil && subdomain == config.AdminSubdomain {
+		return true, nil
+	}
+
+	// if event participants are public, then return false
+	if event.ParticipantsVisibility == model.PublicParticipantsVisibilityType {
+		return false, nil
+	}
+
+	// protect by default
+	return true, nil
+}
+
+func (u *EventUseCase) ApproveParticipants(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error {
+	return u.updateParticipantsStatus(ctx, eventID, model.ApprovedParticipationStatus, userIDs...)
+}
+
+func (u *EventUseCase) RejectParticipants(ctx context.Context, eventID uuid.UUID, userIDs ...uuid.UUID) error {
+	return u.updateParticipantsStatus(ctx, eventID, model.RejectedParticipationStatus, userIDs...)
+}
+
+// updateParticipantsStatus validates all participants before any change, then updates them one by one,
+// the participant that failed to update is returned to the previous status and does not stop the others
+func (u *EventUseCase) updateParticipantsStatus(ctx context.Context, eventID uuid.UUID, status int32, userIDs ...uuid.UUID) error {
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	currentStatuses := make(map[uuid.UUID]int32, len(userIDs))
+	for _, userID := range userIDs {
+		currentStatus, err := u.service.GetParticipantJoinEventStatus(ctx, eventID, userID)
+		if err != nil {
+			return err
+		}
+
+		// user did not request to join the event
+		if currentStatus == model.NoParticipationStatus {
+			return model.ErrParticipantNotFound
+		}
+
+		currentStatuses[userID] = currentStatus
+	}
+
+	var errs error
+	freePlace := false
+
+	for _, userID := range userIDs {
+		currentStatus := currentStatuses[userID]
+
+		if err = u.updateParticipantStatus(ctx, event, userID, currentStatus, status); err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		// rejected participant frees the event place
+		if status == model.RejectedParticipationStatus &&
+			(currentStatus == model.PendingParticipationStatus || currentStatus == model.ApprovedParticipationStatus) {
+			freePlace = true
+		}
+	}
+
+	if freePlace {
+		if err = u.promoteWaitlistedParticipants(ctx, event); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	return nil
+}
+
+func (u *EventUseCase) updateParticipantStatus(ctx context.Context, event *model.Event, userID uuid.UUID, currentStatus, status int32) error {
+	// waitlisted and rejected participants take the event place on approval, so it has to be free
+	if status == model.ApprovedParticipationStatus &&
+		(currentStatus == model.WaitlistedParticipationStatus || currentStatus == model.RejectedParticipationStatus) {
+		full, err := u.isEventFull(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		if full {
+			return model.ErrEventFull
+		}
+	}
+
+	if currentStatus != status {
+		if err := u.service.UpdateParticipantStatus(ctx, event.ID, userID, status); err != nil {
+			return err
+		}
+	}
+
+	// rejected individual participant leaves the own team, so the participant loses the laboratory access
+	// and the team does not take the event place
+	if status == model.RejectedParticipationStatus && currentStatus == model.ApprovedParticipationStatus &&
+		event.Participation == model.IndividualParticipationType {
+		return u.removeIndividualParticipantTeam(ctx, event, userID)
+	}
+
+	// if event participation is individual, approved participant gets the team as on joining the open event
+	if status != model.ApprovedParticipationStatus || event.Participation != model.IndividualParticipationType {
+		return nil
+	}
+
+	// team is already created, if participant was approved before
+	_, err := u.service.GetParticipantTeam(ctx, event.ID, userID)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, model.ErrTeamNotFound) {
+		err = u.createIndividualParticipantTeam(ctx, event, userID)
+	}
+
+	if err != nil {
+		// return participant to the previous status, the approved participant without team can not take part in the event,
+		// the team with failed challenges deployment is kept, because its challenges are deployed again on reconcile
+		if _, teamErr := u.service.GetParticipantTeam(ctx, event.ID, userID); currentStatus != status && errors.Is(teamErr, model.ErrTeamNotFound) {
+			if rollbackErr := u.service.UpdateParticipantStatus(ctx, event.ID, userID, currentStatus); rollbackErr != nil {
+				return multierror.Append(err, rollbackErr)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// createIndividualParticipantTeam creates the team of the individual participant with name as user`s name,
+// if the event teams limit is not reached
+func (u *EventUseCase) createIndividualParticipantTeam(ctx context.Context, event *model.Event, userID uuid.UUID) error {
+	limitReached, err := u.isTeamsLimitReached(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if limitReached {
+		return model.ErrEventTeamsLimitReached
+	}
+
+	// get user
+	user, err := u.service.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return u.createParticipantTeam(ctx, event, userID, user.Name)
+}
+
+// removeIndividualParticipantTeam removes the individual participant from the team and deletes the empty team
+func (u *EventUseCase) removeIndividualParticipantTeam(ctx context.Context, event *model.Event, userID uuid.UUID) error {
+	team, err := u.service.GetParticipantTeam(ctx, event.ID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrTeamNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err = u.service.RemoveParticipantFromTeam(ctx, event.ID, userID); err != nil {
+		return err
+	}
+
+	return u.deleteEmptyTeam(ctx, event.ID, team.ID)
+}
@@ -0,0 +1,213 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"slices"
+)
+
+type (
+	IPrerequisiteService interface {
+		GetEventChallengesPrerequisites(ctx context.Context, eventID uuid.UUID) ([]*model.ChallengePrerequisite, error)
+		UpdateEventChallengePrerequisites(ctx context.Context, eventID, challengeID uuid.UUID, prerequisites []*model.ChallengePrerequisite) error
+		GetTeamSolvedChallengeIDs(ctx context.Context, eventID, teamID uuid.UUID) ([]uuid.UUID, error)
+	}
+)
+
+func (u *EventUseCase) GetEventChallengePrerequisites(ctx context.Context, eventID, challengeID uuid.UUID) ([]*model.ChallengePrerequisite, error) {
+	prerequisites, err := u.service.GetEventChallengesPrerequisites(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.ChallengePrerequisite, 0)
+	for _, prerequisite := range prerequisites {
+		if prerequisite.ChallengeID == challengeID {
+			result = append(result, prerequisite)
+		}
+	}
+
+	return result, nil
+}
+
+func (u *EventUseCase) UpdateEventChallengePrerequisites(ctx context.Context, eventID, challengeID uuid.UUID, prerequisites []*model.ChallengePrerequisite) error {
+	challenges, err := u.service.GetEventChallenges(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	challengeExists := func(id uuid.UUID) bool {
+		return slices.IndexFunc(challenges, func(c *model.Challenge) bool {
+			return c.ID == id
+		}) != -1
+	}
+
+	if !challengeExists(challengeID) {
+		return model.ErrChallengeTaskNotFound
+	}
+
+	for _, prerequisite := range prerequisites {
+		switch prerequisite.Type {
+		case model.ChallengeSolvedPrerequisiteType, model.ChallengesCountPrerequisiteType:
+			if len(prerequisite.ChallengeIDs) == 0 {
+				return model.ErrChallengePrerequisiteInvalid
+			}
+
+			for _, id := range prerequisite.ChallengeIDs {
+				// the challenge can not depend on itself
+				if id == challengeID || !challengeExists(id) {
+					return model.ErrChallengePrerequisiteInvalid
+				}
+			}
+
+			if prerequisite.Type == model.ChallengesCountPrerequisiteType &&
+				(prerequisite.RequiredCount < 1 || int(prerequisite.RequiredCount) > len(prerequisite.ChallengeIDs)) {
+				return model.ErrChallengePrerequisiteInvalid
+			}
+		case model.ScorePrerequisiteType:
+			if prerequisite.RequiredScore < 1 {
+				return model.ErrChallengePrerequisiteInvalid
+			}
+		default:
+			return model.ErrChallengePrerequisiteInvalid
+		}
+	}
+
+	// the challenge can not depend on itself through other challenges, because it would never be unlocked
+	existing, err := u.service.GetEventChallengesPrerequisites(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if hasPrerequisitesCycle(challengeID, existing, prerequisites) {
+		return model.ErrChallengePrerequisiteInvalid
+	}
+
+	return u.service.UpdateEventChallengePrerequisites(ctx, eventID, challengeID, prerequisites)
+}
+
+// hasPrerequisitesCycle checks if the challenge is reachable from its new prerequisites,
+// the existing prerequisites of the challenge are replaced by the new ones
+func hasPrerequisitesCycle(challengeID uuid.UUID, existing, prerequisites []*model.ChallengePrerequisite) bool {
+	dependencies := make(map[uuid.UUID][]uuid.UUID)
+	for _, prerequisite := range existing {
+		if prerequisite.ChallengeID == challengeID {
+			continue
+		}
+		dependencies[prerequisite.ChallengeID] = append(dependencies[prerequisite.ChallengeID], prerequisite.ChallengeIDs...)
+	}
+
+	for _, prerequisite := range prerequisites {
+		dependencies[challengeID] = append(dependencies[challengeID], prerequisite.ChallengeIDs...)
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	queue := slices.Clone(dependencies[challengeID])
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if id == challengeID {
+			return true
+		}
+
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		queue = append(queue, dependencies[id]...)
+	}
+
+	return false
+}
+
+// getLockedChallenges returns the challenges of the event whose prerequisites are not met by the team,
+// a challenge is unlocked when all its prerequisites are met
+func (u *EventUseCase) getLockedChallenges(ctx context.Context, eventID uuid.UUID, team *model.Team) (map[uuid.UUID]bool, error) {
+	locked := make(map[uuid.UUID]bool)
+
+	// administrators do not have a real team and see all challenges
+	if team.ID.IsNil() {
+		return locked, nil
+	}
+
+	prerequisites, err := u.service.GetEventChallengesPrerequisites(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(prerequisites) == 0 {
+		return locked, nil
+	}
+
+	solvedIDs, err := u.service.GetTeamSolvedChallengeIDs(ctx, eventID, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	solved := make(map[uuid.UUID]bool)
+	for _, id := range solvedIDs {
+		solved[id] = true
+	}
+
+	// the team score is calculated only if there is a score prerequisite
+	score, scoreCalculated := 0, false
+	getScore := func() (int, error) {
+		if scoreCalculated {
+			return score, nil
+		}
+
+		eventScore, err := u.service.GetScore(ctx, eventID)
+		if err != nil {
+			return 0, err
+		}
+
+		scoreCalculated = true
+		for _, teamScore := range eventScore.TeamsScores {
+			if teamScore.TeamID == team.ID {
+				score = teamScore.Score
+			}
+		}
+
+		return score, nil
+	}
+
+	for _, prerequisite := range prerequisites {
+		if locked[prerequisite.ChallengeID] {
+			continue
+		}
+
+		solvedCount := 0
+		for _, id := range prerequisite.ChallengeIDs {
+			if solved[id] {
+				solvedCount++
+			}
+		}
+
+		switch prerequisite.Type {
+		case model.ChallengeSolvedPrerequisiteType:
+			locked[prerequisite.ChallengeID] = solvedCount < len(prerequisite.ChallengeIDs)
+		case model.ChallengesCountPrerequisiteType:
+			locked[prerequisite.ChallengeID] = solvedCount < int(prerequisite.RequiredCount)
+		case model.ScorePrerequisiteType:
+			teamScore, err := getScore()
+			if err != nil {
+				return nil, err
+			}
+			locked[prerequisite.ChallengeID] = teamScore < int(prerequisite.RequiredScore)
+		}
+	}
+
+	return locked, nil
+}
+
+func (u *EventUseCase) isChallengeLocked(ctx context.Context, eventID, challengeID uuid.UUID, team *model.Team) (bool, error) {
+	locked, err := u.getLockedChallenges(ctx, eventID, team)
+	if err != nil {
+		return false, err
+	}
+
+	return locked[challengeID], nil
+}
@@ -0,0 +1,96 @@
+package event
+
+import (
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"testing"
+)
+
+func TestHasPrerequisitesCycle(t *testing.T) {
+	a, b, c, d := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+
+	prerequisite := func(challengeID uuid.UUID, challengeIDs ...uuid.UUID) *model.ChallengePrerequisite {
+		return &model.ChallengePrerequisite{
+			ChallengeID:  challengeID,
+			Type:         model.ChallengeSolvedPrerequisiteType,
+			ChallengeIDs: challengeIDs,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		challengeID   uuid.UUID
+		existing      []*model.ChallengePrerequisite
+		prerequisites []*model.ChallengePrerequisite
+		want          bool
+	}{
+		{
+			name:          "no prerequisites",
+			challengeID:   a,
+			prerequisites: nil,
+			want:          false,
+		},
+		{
+			name:          "chain without cycle",
+			challengeID:   a,
+			existing:      []*model.ChallengePrerequisite{prerequisite(b, c), prerequisite(c, d)},
+			prerequisites: []*model.ChallengePrerequisite{prerequisite(a, b)},
+			want:          false,
+		},
+		{
+			name:          "direct cycle",
+			challengeID:   a,
+			existing:      []*model.ChallengePrerequisite{prerequisite(b, a)},
+			prerequisites: []*model.ChallengePrerequisite{prerequisite(a, b)},
+			want:          true,
+		},
+		{
+			name:          "indirect cycle",
+			challengeID:   a,
+			existing:      []*model.ChallengePrerequisite{prerequisite(b, c), prerequisite(c, a)},
+			prerequisites: []*model.ChallengePrerequisite{prerequisite(a, b)},
+			want:          true,
+		},
+		{
+			name:        "cycle through the challenges count prerequisite",
+			challengeID: a,
+			existing: []*model.ChallengePrerequisite{{
+				ChallengeID:   b,
+				Type:          model.ChallengesCountPrerequisiteType,
+				ChallengeIDs:  []uuid.UUID{c, a},
+				RequiredCount: 1,
+			}},
+			prerequisites: []*model.ChallengePrerequisite{prerequisite(a, b)},
+			want:          true,
+		},
+		{
+			name:          "existing prerequisites of the challenge are replaced",
+			challengeID:   a,
+			existing:      []*model.ChallengePrerequisite{prerequisite(a, b), prerequisite(c, a)},
+			prerequisites: []*model.ChallengePrerequisite{prerequisite(a, d)},
+			want:          false,
+		},
+		{
+			name:          "shared dependency is not a cycle",
+			challengeID:   a,
+			existing:      []*model.ChallengePrerequisite{prerequisite(b, d), prerequisite(c, d)},
+			prerequisites: []*model.ChallengePrerequisite{prerequisite(a, b, c)},
+			want:          false,
+		},
+		{
+			name:          "cycle not involving the challenge",
+			challengeID:   a,
+			existing:      []*model.ChallengePrerequisite{prerequisite(b, c), prerequisite(c, b)},
+			prerequisites: []*model.ChallengePrerequisite{prerequisite(a, b)},
+			want:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasPrerequisitesCycle(tt.challengeID, tt.existing, tt.prerequisites); got != tt.want {
+				t.Errorf("hasPrerequisitesCycle() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
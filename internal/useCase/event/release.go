@@ -0,0 +1,94 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+// challengeReleaseTime returns the time the challenge becomes available for participants,
+// the challenge is not released before its category and the event start
+func challengeReleaseTime(event *model.Event, category *model.ChallengeCategory, challenge *model.Challenge) time.Time {
+	releaseTime := event.StartTime
+
+	if category != nil && category.ReleaseTime != nil && category.ReleaseTime.After(releaseTime) {
+		releaseTime = *category.ReleaseTime
+	}
+
+	if challenge.ReleaseTime != nil && challenge.ReleaseTime.After(releaseTime) {
+		releaseTime = *challenge.ReleaseTime
+	}
+
+	return releaseTime
+}
+
+func categoryReleaseTime(event *model.Event, category *model.ChallengeCategory) time.Time {
+	if category.ReleaseTime != nil && category.ReleaseTime.After(event.StartTime) {
+		return *category.ReleaseTime
+	}
+
+	return event.StartTime
+}
+
+func (u *EventUseCase) UpdateEventChallengeReleaseTime(ctx context.Context, eventID, challengeID uuid.UUID, releaseTime *time.Time) error {
+	if err := u.service.UpdateEventChallengeReleaseTime(ctx, eventID, challengeID, releaseTime); err != nil {
+		return err
+	}
+
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	return u.scheduleChallengesRelease(ctx, event)
+}
+
+// scheduleChallengesRelease reschedules the challenges deployment after the release times are changed,
+// the following waves are scheduled by the deployment job itself
+func (u *EventUseCase) scheduleChallengesRelease(ctx context.Context, event *model.Event) error {
+	if laboratoriesReleaseTime(event).Before(time.Now().UTC()) {
+		return nil
+	}
+
+	runAt := time.Now().UTC()
+	if startDeploy := event.StartTime.Add(-time.Minute); startDeploy.After(runAt) {
+		runAt = startDeploy
+	}
+
+	return u.scheduleEventJob(ctx, model.ReconcileEventChallengesJobType, event.ID, runAt)
+}
+
+// scheduleNextChallengesRelease schedules the deployment of the next challenges release wave
+func (u *EventUseCase) scheduleNextChallengesRelease(ctx context.Context, event *model.Event) error {
+	challenges, err := u.service.GetEventChallenges(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	categories, err := u.service.GetEventCategories(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	categoriesByID := make(map[uuid.UUID]*model.ChallengeCategory, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+	}
+
+	var next *time.Time
+	for _, challenge := range challenges {
+		provisionTime := challengeReleaseTime(event, categoriesByID[challenge.CategoryID], challenge).Add(-config.ChallengeProvisionLeadTime)
+
+		if provisionTime.After(time.Now().UTC()) && (next == nil || provisionTime.Before(*next)) {
+			next = &provisionTime
+		}
+	}
+
+	if next == nil || next.After(laboratoriesReleaseTime(event)) {
+		return nil
+	}
+
+	return u.scheduleEventJob(ctx, model.ReconcileEventChallengesJobType, event.ID, *next)
+}
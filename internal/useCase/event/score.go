@@ -11,46 +11,155 @@ import (
 type (
 	IScoreService interface {
 		GetScore(ctx context.Context, eventID uuid.UUID) (*model.EventScore, error)
+		GetScoreAt(ctx context.Context, eventID uuid.UUID, at time.Time, teamID uuid.UUID) (*model.EventScore, error)
+		GetFrozenScore(ctx context.Context, eventID, teamID uuid.UUID) (*model.EventScore, error)
+		UpdateEventScoreboardRevealed(ctx context.Context, eventID uuid.UUID, revealed bool) error
+		SubscribeScore(eventID uuid.UUID) (<-chan struct{}, func())
+	}
+
+	// scoreViewer is the user, who the scoreboard is shown to
+	scoreViewer struct {
+		administrator bool
+		participant   bool // the user has the team in the event or is an administrator
+		teamID        uuid.UUID
 	}
 )
 
-func (u *EventUseCase) GetScore(ctx context.Context, eventID uuid.UUID) (*model.EventScore, error) {
+// GetScore returns the event standings, if the time is given, the standings are replayed at that time
+func (u *EventUseCase) GetScore(ctx context.Context, eventID uuid.UUID, at *time.Time) (*model.EventScore, error) {
 	event, err := u.service.GetEventByID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
 
+	return u.getViewerScore(ctx, event, u.getScoreViewer(ctx, eventID), at)
+}
+
+// getScoreViewer resolves the current user for the scoreboard, so the standings can be got without the request values
+func (u *EventUseCase) getScoreViewer(ctx context.Context, eventID uuid.UUID) *scoreViewer {
+	userRole, err := tools.GetCurrentUserRoleFromContext(ctx)
+	viewer := &scoreViewer{
+		administrator: err == nil && userRole == model.AdministratorRole,
+	}
+
+	// participants see the real solves of their team on the frozen scoreboard
+	if team, err := u.GetSelfTeam(ctx, eventID); err == nil {
+		viewer.participant = true
+		viewer.teamID = team.ID
+	}
+
+	return viewer
+}
+
+// getViewerScore returns the standings, if the scoreboard is available for the viewer
+func (u *EventUseCase) getViewerScore(ctx context.Context, event *model.Event, viewer *scoreViewer, at *time.Time) (*model.EventScore, error) {
 	// if event has not started yet, anyone can't see the scoreboard
 	if event.StartTime.After(time.Now().UTC()) {
 		return nil, model.ErrScoreNotAvailable
 	}
 
+	switch event.ScoreboardAvailability {
 	// if scoreboard is public, then return the scoreboard
-	if event.ScoreboardAvailability == model.PublicScoreboardAvailabilityType {
-		return u.service.GetScore(ctx, eventID)
+	case model.PublicScoreboardAvailabilityType:
+		return u.getScore(ctx, event, viewer, at)
+	// return private scoreboard only if the user is a participant
+	case model.PrivateScoreboardAvailabilityType:
+		if viewer.participant {
+			return u.getScore(ctx, event, viewer, at)
+		}
+	// return hidden scoreboard only if the user is an administrator
+	case model.HiddenScoreboardAvailabilityType:
+		if viewer.administrator {
+			return u.getScore(ctx, event, viewer, at)
+		}
 	}
 
-	// return private scoreboard only if the user is a participant
-	if event.ScoreboardAvailability == model.PrivateScoreboardAvailabilityType {
-		if _, err := u.GetSelfTeam(ctx, eventID); err != nil {
-			return nil, model.ErrScoreNotAvailable
+	return nil, model.ErrScoreNotAvailable
+}
+
+// getScore returns the frozen standings after the scoreboard freeze until they are revealed,
+// the administrators always see the real standings
+func (u *EventUseCase) getScore(ctx context.Context, event *model.Event, viewer *scoreViewer, at *time.Time) (*model.EventScore, error) {
+	frozen := scoreboardFrozen(event, viewer.administrator)
+
+	if at != nil {
+		// the standings after the freeze can't be replayed until they are revealed,
+		// so they are replayed at the freeze time as the frozen standings
+		if frozen && at.After(*event.ScoreboardFreezeTime) {
+			return u.service.GetScoreAt(ctx, event.ID, *event.ScoreboardFreezeTime, viewer.teamID)
 		}
+		return u.service.GetScoreAt(ctx, event.ID, *at, uuid.Nil)
+	}
+
+	if !frozen {
+		return u.service.GetScore(ctx, event.ID)
+	}
+
+	return u.service.GetFrozenScore(ctx, event.ID, viewer.teamID)
+}
+
+// getViewerTeamID returns the team of the current user, participants see the real solves of their team on the frozen scoreboard
+func (u *EventUseCase) getViewerTeamID(ctx context.Context, eventID uuid.UUID) uuid.UUID {
+	if team, err := u.GetSelfTeam(ctx, eventID); err == nil {
+		return team.ID
+	}
+	return uuid.Nil
+}
+
+// isScoreboardFrozen returns true if the current user sees the frozen standings
+func (u *EventUseCase) isScoreboardFrozen(ctx context.Context, event *model.Event) bool {
+	userRole, err := tools.GetCurrentUserRoleFromContext(ctx)
+	return scoreboardFrozen(event, err == nil && userRole == model.AdministratorRole)
+}
+
+// scoreboardFrozen returns true if the scoreboard is frozen for the viewer,
+// the scoreboard is frozen after the freeze time until it is revealed, except for the administrators
+func scoreboardFrozen(event *model.Event, administrator bool) bool {
+	if event.ScoreboardFreezeTime == nil || event.ScoreboardRevealed || time.Now().UTC().Before(*event.ScoreboardFreezeTime) {
+		return false
+	}
+
+	return !administrator
+}
+
+func (u *EventUseCase) RevealScoreboard(ctx context.Context, eventID uuid.UUID, revealed bool) error {
+	return u.service.UpdateEventScoreboardRevealed(ctx, eventID, revealed)
+}
+
+// ExportScore returns the standings for the export, administrators can export them at any time,
+// others only after the event is finished and if the scoreboard is public
+func (u *EventUseCase) ExportScore(ctx context.Context, eventID uuid.UUID) (*model.EventScore, error) {
+	event, err := u.service.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userRole, err := tools.GetCurrentUserRoleFromContext(ctx); err == nil && userRole == model.AdministratorRole {
 		return u.service.GetScore(ctx, eventID)
 	}
 
-	// return hidden scoreboard only if the user is an administrator
-	if event.ScoreboardAvailability == model.HiddenScoreboardAvailabilityType {
-		userRole, err := tools.GetCurrentUserRoleFromContext(ctx)
-		if err != nil {
-			return nil, err
-		}
-		if userRole == model.AdministratorRole {
-			return u.service.GetScore(ctx, eventID)
-		}
+	if event.ScoreboardAvailability == model.PublicScoreboardAvailabilityType && event.FinishTime.Before(time.Now().UTC()) {
+		return u.getScore(ctx, event, u.getScoreViewer(ctx, eventID), nil)
 	}
+
 	return nil, model.ErrScoreNotAvailable
 }
 
+func (u *EventUseCase) ProtectScoreExport(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	event, err := u.service.GetEventByID(ctx, eventID)
+	if err != nil {
+		return true, err
+	}
+
+	// if event is finished and its scoreboard is public, then anyone can export it
+	if event.ScoreboardAvailability == model.PublicScoreboardAvailabilityType && event.FinishTime.Before(time.Now().UTC()) {
+		return false, nil
+	}
+
+	// protect by default
+	return true, nil
+}
+
 func (u *EventUseCase) ProtectScore(ctx context.Context, eventID uuid.UUID) (bool, error) {
 	event, err := u.service.GetEventByID(ctx, eventID)
 	if err != nil {
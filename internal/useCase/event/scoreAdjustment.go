@@ -0,0 +1,52 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"strings"
+)
+
+type (
+	IScoreAdjustmentService interface {
+		GetEventScoreAdjustments(ctx context.Context, eventID uuid.UUID) ([]*model.ScoreAdjustment, error)
+		CreateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) (*model.ScoreAdjustment, error)
+		UpdateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) error
+		DeleteEventScoreAdjustment(ctx context.Context, eventID, adjustmentID uuid.UUID) error
+	}
+)
+
+func (u *EventUseCase) GetEventScoreAdjustments(ctx context.Context, eventID uuid.UUID) ([]*model.ScoreAdjustment, error) {
+	return u.service.GetEventScoreAdjustments(ctx, eventID)
+}
+
+func (u *EventUseCase) CreateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) (*model.ScoreAdjustment, error) {
+	if err := validateScoreAdjustment(adjustment); err != nil {
+		return nil, err
+	}
+
+	return u.service.CreateEventScoreAdjustment(ctx, adjustment)
+}
+
+func (u *EventUseCase) UpdateEventScoreAdjustment(ctx context.Context, adjustment *model.ScoreAdjustment) error {
+	if err := validateScoreAdjustment(adjustment); err != nil {
+		return err
+	}
+
+	return u.service.UpdateEventScoreAdjustment(ctx, adjustment)
+}
+
+func (u *EventUseCase) DeleteEventScoreAdjustment(ctx context.Context, eventID, adjustmentID uuid.UUID) error {
+	return u.service.DeleteEventScoreAdjustment(ctx, eventID, adjustmentID)
+}
+
+// validateScoreAdjustment checks the adjustment changes the score and the reason is given for the scoreboard history
+func validateScoreAdjustment(adjustment *model.ScoreAdjustment) error {
+	adjustment.Reason = strings.TrimSpace(adjustment.Reason)
+
+	if adjustment.Points == 0 || adjustment.Reason == "" {
+		return model.ErrScoreAdjustmentInvalid
+	}
+
+	return nil
+}
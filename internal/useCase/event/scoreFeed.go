@@ -0,0 +1,133 @@
+package event
+
+import (
+	"context"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"sort"
+)
+
+// GetScoreFeed returns the stream of the event score updates, solves and first bloods,
+// the stream is available under the same rules as the scoreboard and is closed when the context is done
+// or the scoreboard is not available anymore, the viewer is resolved once, so the stream does not use the request values
+func (u *EventUseCase) GetScoreFeed(ctx context.Context, eventID uuid.UUID) (<-chan *model.ScoreFeedEvent, error) {
+	event, err := u.service.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer := u.getScoreViewer(ctx, eventID)
+
+	score, err := u.getViewerScore(ctx, event, viewer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, unsubscribe := u.service.SubscribeScore(eventID)
+
+	feed := make(chan *model.ScoreFeedEvent)
+	go func() {
+		defer close(feed)
+		defer unsubscribe()
+
+		send := func(event *model.ScoreFeedEvent) bool {
+			select {
+			case feed <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !send(&model.ScoreFeedEvent{Type: model.ScoreUpdatedFeedEventType, Score: score}) {
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changes:
+				// the event is got again, because the scoreboard availability and freeze could be changed
+				event, err := u.service.GetEventByID(ctx, eventID)
+				if err != nil {
+					return
+				}
+
+				newScore, err := u.getViewerScore(ctx, event, viewer, nil)
+				if err != nil {
+					return
+				}
+
+				for _, solve := range newSolves(score, newScore) {
+					if !send(&model.ScoreFeedEvent{Type: model.SolveFeedEventType, Solve: solve}) {
+						return
+					}
+
+					if solve.Rank == 1 && !send(&model.ScoreFeedEvent{Type: model.FirstBloodFeedEventType, Solve: solve}) {
+						return
+					}
+				}
+
+				if !send(&model.ScoreFeedEvent{Type: model.ScoreUpdatedFeedEventType, Score: newScore}) {
+					return
+				}
+
+				score = newScore
+			}
+		}
+	}()
+
+	return feed, nil
+}
+
+// newSolves returns the solutions of the new score, which are not in the previous one, in the solve order,
+// the solutions of the restored teams and challenges are not new, so they are skipped
+func newSolves(previous, current *model.EventScore) []*model.SolveInfo {
+	previousChallenges := make(map[uuid.UUID]bool, len(previous.ChallengeList))
+	for _, challenge := range previous.ChallengeList {
+		previousChallenges[challenge.ID] = true
+	}
+
+	previousSolutions := make(map[uuid.UUID]map[uuid.UUID]bool, len(previous.TeamsScores))
+	for _, teamScore := range previous.TeamsScores {
+		previousSolutions[teamScore.TeamID] = make(map[uuid.UUID]bool, len(teamScore.TeamSolutions))
+		for challengeID := range teamScore.TeamSolutions {
+			previousSolutions[teamScore.TeamID][challengeID] = true
+		}
+	}
+
+	challengeNames := make(map[uuid.UUID]string, len(current.ChallengeList))
+	for _, challenge := range current.ChallengeList {
+		challengeNames[challenge.ID] = challenge.Name
+	}
+
+	var result []*model.SolveInfo
+	for _, teamScore := range current.TeamsScores {
+		teamSolutions, ok := previousSolutions[teamScore.TeamID]
+		if !ok {
+			continue
+		}
+
+		for challengeID, solution := range teamScore.TeamSolutions {
+			if teamSolutions[challengeID] || !previousChallenges[challengeID] {
+				continue
+			}
+
+			result = append(result, &model.SolveInfo{
+				ChallengeID:   challengeID,
+				ChallengeName: challengeNames[challengeID],
+				TeamID:        teamScore.TeamID,
+				TeamName:      teamScore.TeamName,
+				Rank:          solution.Rank,
+				SolvedAt:      solution.SolvedAt,
+			})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].SolvedAt.Before(result[j].SolvedAt)
+	})
+
+	return result
+}
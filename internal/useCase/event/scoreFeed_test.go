@@ -0,0 +1,84 @@
+package event
+
+import (
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"testing"
+	"time"
+)
+
+func TestNewSolves(t *testing.T) {
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	teamA, teamB := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	challengeX, challengeY := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+
+	challenges := []model.ChallengeInfo{{ID: challengeX, Name: "x"}, {ID: challengeY, Name: "y"}}
+
+	score := func(challengeList []model.ChallengeInfo, teams ...model.TeamScore) *model.EventScore {
+		return &model.EventScore{TeamsScores: teams, ChallengeList: challengeList}
+	}
+	team := func(id uuid.UUID, solutions map[uuid.UUID]model.TeamSolution) model.TeamScore {
+		return model.TeamScore{TeamID: id, TeamName: id.String(), TeamSolutions: solutions}
+	}
+	solved := func(rank int, seconds int) model.TeamSolution {
+		return model.TeamSolution{Rank: rank, SolvedAt: now.Add(time.Duration(seconds) * time.Second)}
+	}
+
+	tests := []struct {
+		name     string
+		previous *model.EventScore
+		current  *model.EventScore
+		want     []model.SolveInfo
+	}{
+		{
+			name:     "no changes",
+			previous: score(challenges, team(teamA, map[uuid.UUID]model.TeamSolution{challengeX: solved(1, 1)})),
+			current:  score(challenges, team(teamA, map[uuid.UUID]model.TeamSolution{challengeX: solved(1, 1)})),
+			want:     nil,
+		},
+		{
+			name:     "new solves are ordered by solve time",
+			previous: score(challenges, team(teamA, nil), team(teamB, nil)),
+			current: score(challenges,
+				team(teamA, map[uuid.UUID]model.TeamSolution{challengeX: solved(2, 20)}),
+				team(teamB, map[uuid.UUID]model.TeamSolution{challengeX: solved(1, 10), challengeY: solved(1, 30)}),
+			),
+			want: []model.SolveInfo{
+				{ChallengeID: challengeX, ChallengeName: "x", TeamID: teamB, Rank: 1, SolvedAt: now.Add(10 * time.Second)},
+				{ChallengeID: challengeX, ChallengeName: "x", TeamID: teamA, Rank: 2, SolvedAt: now.Add(20 * time.Second)},
+				{ChallengeID: challengeY, ChallengeName: "y", TeamID: teamB, Rank: 1, SolvedAt: now.Add(30 * time.Second)},
+			},
+		},
+		{
+			name:     "solves of the restored team are not new",
+			previous: score(challenges, team(teamA, nil)),
+			current: score(challenges,
+				team(teamA, nil),
+				team(teamB, map[uuid.UUID]model.TeamSolution{challengeX: solved(1, 10)}),
+			),
+			want: nil,
+		},
+		{
+			name:     "solves of the restored challenge are not new",
+			previous: score(challenges[:1], team(teamA, nil)),
+			current:  score(challenges, team(teamA, map[uuid.UUID]model.TeamSolution{challengeY: solved(1, 10)})),
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newSolves(tt.previous, tt.current)
+			if len(got) != len(tt.want) {
+				t.Fatalf("newSolves() returned %d solves, want %d", len(got), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				if got[i].ChallengeID != want.ChallengeID || got[i].ChallengeName != want.ChallengeName ||
+					got[i].TeamID != want.TeamID || got[i].Rank != want.Rank || !got[i].SolvedAt.Equal(want.SolvedAt) {
+					t.Errorf("solve %d = %+v, want %+v", i, *got[i], want)
+				}
+			}
+		})
+	}
+}
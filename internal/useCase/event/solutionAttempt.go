@@ -0,0 +1,62 @@
+package event
+
+import (
+	"github.com/cybericebox/daemon/internal/model"
+	"time"
+)
+
+// solutionAttemptsState returns the state of the team attempts on the challenge according to the event limits,
+// attempts have to be ordered from the latest one
+func solutionAttemptsState(event *model.Event, attempts []time.Time, now time.Time) *model.SolutionAttemptResult {
+	result := &model.SolutionAttemptResult{
+		RemainingAttempts: -1,
+	}
+
+	if event.SolutionAttemptsLimit > 0 {
+		window := time.Duration(event.SolutionAttemptsWindow) * time.Second
+		windowStart := now.Add(-window)
+
+		recent := int32(0)
+		for _, attempt := range attempts {
+			if !attempt.After(windowStart) {
+				break
+			}
+			recent++
+		}
+
+		result.RemainingAttempts = max(event.SolutionAttemptsLimit-recent, 0)
+
+		if result.RemainingAttempts == 0 {
+			// the team is blocked until the limit attempt leaves the window
+			retryAfter := attempts[event.SolutionAttemptsLimit-1].Add(window)
+			result.RetryAfter = &retryAfter
+		}
+
+		// the latest attempt reached the limit, if the limit attempts were made within the window,
+		// then the team is blocked until the cooldown is over even after the window slides
+		if event.SolutionAttemptsCooldown > 0 && int32(len(attempts)) >= event.SolutionAttemptsLimit &&
+			attempts[event.SolutionAttemptsLimit-1].After(attempts[0].Add(-window)) {
+			if cooldownEnd := attempts[0].Add(time.Duration(event.SolutionAttemptsCooldown) * time.Second); cooldownEnd.After(now) {
+				result.RemainingAttempts = 0
+				if result.RetryAfter == nil || cooldownEnd.After(*result.RetryAfter) {
+					result.RetryAfter = &cooldownEnd
+				}
+			}
+		}
+	}
+
+	if event.MaxSolutionAttempts > 0 {
+		remaining := max(event.MaxSolutionAttempts-int32(len(attempts)), 0)
+
+		// exhausted attempts are not restored over time
+		if remaining == 0 {
+			result.RetryAfter = nil
+		}
+
+		if result.RemainingAttempts == -1 || remaining < result.RemainingAttempts {
+			result.RemainingAttempts = remaining
+		}
+	}
+
+	return result
+}
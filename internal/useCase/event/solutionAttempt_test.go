@@ -0,0 +1,114 @@
+package event
+
+import (
+	"github.com/cybericebox/daemon/internal/model"
+	"testing"
+	"time"
+)
+
+func TestSolutionAttemptsState(t *testing.T) {
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	ago := func(seconds int) time.Time {
+		return now.Add(-time.Duration(seconds) * time.Second)
+	}
+	at := func(tm time.Time) *time.Time {
+		return &tm
+	}
+
+	tests := []struct {
+		name              string
+		event             *model.Event
+		attempts          []time.Time
+		remainingAttempts int32
+		retryAfter        *time.Time
+	}{
+		{
+			name:              "unlimited",
+			event:             &model.Event{},
+			attempts:          []time.Time{ago(1), ago(2), ago(3)},
+			remainingAttempts: -1,
+		},
+		{
+			name:              "limit not reached",
+			event:             &model.Event{SolutionAttemptsLimit: 3, SolutionAttemptsWindow: 60},
+			attempts:          []time.Time{ago(10), ago(20)},
+			remainingAttempts: 1,
+		},
+		{
+			name:              "limit reached within the window",
+			event:             &model.Event{SolutionAttemptsLimit: 3, SolutionAttemptsWindow: 60},
+			attempts:          []time.Time{ago(10), ago(20), ago(30)},
+			remainingAttempts: 0,
+			retryAfter:        at(ago(30).Add(time.Minute)),
+		},
+		{
+			name:              "old attempts left the window",
+			event:             &model.Event{SolutionAttemptsLimit: 3, SolutionAttemptsWindow: 60},
+			attempts:          []time.Time{ago(10), ago(70), ago(80)},
+			remainingAttempts: 2,
+		},
+		{
+			name:              "cooldown is longer than the window",
+			event:             &model.Event{SolutionAttemptsLimit: 3, SolutionAttemptsWindow: 60, SolutionAttemptsCooldown: 300},
+			attempts:          []time.Time{ago(10), ago(20), ago(30)},
+			remainingAttempts: 0,
+			retryAfter:        at(ago(10).Add(5 * time.Minute)),
+		},
+		{
+			name:              "cooldown lasts after the window slides",
+			event:             &model.Event{SolutionAttemptsLimit: 3, SolutionAttemptsWindow: 60, SolutionAttemptsCooldown: 300},
+			attempts:          []time.Time{ago(61), ago(62), ago(63)},
+			remainingAttempts: 0,
+			retryAfter:        at(ago(61).Add(5 * time.Minute)),
+		},
+		{
+			name:              "cooldown is over",
+			event:             &model.Event{SolutionAttemptsLimit: 3, SolutionAttemptsWindow: 60, SolutionAttemptsCooldown: 300},
+			attempts:          []time.Time{ago(301), ago(302), ago(303)},
+			remainingAttempts: 3,
+		},
+		{
+			name:              "cooldown is not started, the limit attempts were not within the window",
+			event:             &model.Event{SolutionAttemptsLimit: 3, SolutionAttemptsWindow: 60, SolutionAttemptsCooldown: 300},
+			attempts:          []time.Time{ago(10), ago(50), ago(100)},
+			remainingAttempts: 1,
+		},
+		{
+			name:              "total attempts exhausted",
+			event:             &model.Event{MaxSolutionAttempts: 2},
+			attempts:          []time.Time{ago(1000), ago(2000)},
+			remainingAttempts: 0,
+		},
+		{
+			name:              "total attempts exhausted are not restored by the window",
+			event:             &model.Event{SolutionAttemptsLimit: 2, SolutionAttemptsWindow: 60, MaxSolutionAttempts: 2},
+			attempts:          []time.Time{ago(10), ago(20)},
+			remainingAttempts: 0,
+		},
+		{
+			name:              "the lowest remaining attempts are returned",
+			event:             &model.Event{SolutionAttemptsLimit: 5, SolutionAttemptsWindow: 60, MaxSolutionAttempts: 3},
+			attempts:          []time.Time{ago(10)},
+			remainingAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := solutionAttemptsState(tt.event, tt.attempts, now)
+
+			if result.RemainingAttempts != tt.remainingAttempts {
+				t.Errorf("RemainingAttempts = %d, want %d", result.RemainingAttempts, tt.remainingAttempts)
+			}
+
+			switch {
+			case tt.retryAfter == nil && result.RetryAfter != nil:
+				t.Errorf("RetryAfter = %v, want nil", *result.RetryAfter)
+			case tt.retryAfter != nil && result.RetryAfter == nil:
+				t.Errorf("RetryAfter = nil, want %v", *tt.retryAfter)
+			case tt.retryAfter != nil && !result.RetryAfter.Equal(*tt.retryAfter):
+				t.Errorf("RetryAfter = %v, want %v", *result.RetryAfter, *tt.retryAfter)
+			}
+		})
+	}
+}
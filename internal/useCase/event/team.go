@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/cybericebox/daemon/internal/config"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/internal/tools"
 	"github.com/gofrs/uuid"
+	"time"
 )
 
 type (
@@ -16,8 +18,16 @@ type (
 
 		GetParticipantVPNConfig(ctx context.Context, participantID, labCIDR string) (string, error)
 
-		CreateTeam(ctx context.Context, eventID uuid.UUID, name string, laboratoryID *uuid.UUID) error
-		JoinTeam(ctx context.Context, eventID uuid.UUID, name, joinCode string) error
+		CreateTeam(ctx context.Context, eventID, userID uuid.UUID, name string, laboratoryID *uuid.UUID) (*model.Team, error)
+		JoinTeam(ctx context.Context, eventID uuid.UUID, name, joinCode string, maxTeamSize int32) error
+
+		GetTeamMembers(ctx context.Context, eventID, teamID uuid.UUID) ([]*model.TeamMember, error)
+		RenameTeam(ctx context.Context, eventID, teamID uuid.UUID, name string) error
+		RegenerateTeamJoinCode(ctx context.Context, teamID uuid.UUID) (string, error)
+		UpdateTeamCaptain(ctx context.Context, teamID uuid.UUID, captainID *uuid.UUID) error
+		RemoveParticipantFromTeam(ctx context.Context, eventID, userID uuid.UUID) error
+		DeleteTeam(ctx context.Context, eventID, teamID uuid.UUID) error
+		TeamHasActivity(ctx context.Context, teamID uuid.UUID) (bool, error)
 
 		CreateLaboratory(ctx context.Context, networkMask int) (uuid.UUID, error)
 		GetLaboratories(ctx context.Context, labIDs ...uuid.UUID) ([]*model.LabInfo, error)
@@ -56,17 +66,65 @@ func (u *EventUseCase) CreateTeam(ctx context.Context, eventID uuid.UUID, name s
 		}
 	}
 
+	// get current userID
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	limitReached, err := u.isTeamsLimitReached(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if limitReached {
+		// the individual participant takes part only with own team, so the participant waits for the free place
+		if event.Participation == model.IndividualParticipationType {
+			return u.service.UpdateParticipantStatus(ctx, eventID, userID, model.WaitlistedParticipationStatus)
+		}
+
+		// the participant of the team event can join one of the existing teams
+		return model.ErrEventTeamsLimitReached
+	}
+
+	return u.createParticipantTeam(ctx, event, userID, name)
+}
+
+// createParticipantTeam creates the team with the laboratory and the challenges for the participant
+func (u *EventUseCase) createParticipantTeam(ctx context.Context, event *model.Event, userID uuid.UUID, name string) error {
+	// laboratories of the event are already released, so the team does not get one
+	if laboratoriesReleaseTime(event).Before(time.Now().UTC()) {
+		if _, err := u.service.CreateTeam(ctx, event.ID, userID, name, nil); err != nil {
+			return err
+		}
+		return nil
+	}
+
 	// create laboratory
-	laboratoryID, err := u.service.CreateLaboratory(ctx, 26)
+	laboratoryID, err := u.service.CreateLaboratory(ctx, config.LaboratoryCIDRMask)
 	if err != nil {
 		return err
 	}
 
 	// create team
-	if err = u.service.CreateTeam(ctx, eventID, name, &laboratoryID); err != nil {
+	team, err := u.service.CreateTeam(ctx, event.ID, userID, name, &laboratoryID)
+	if err != nil {
 		return err
 	}
 
+	// if event challenges are already created for teams, create them for the new team right now,
+	// because the event start task will not be run again
+	if event.StartTime.Add(-time.Minute).Before(time.Now().UTC()) {
+		if err = u.service.ReconcileEventTeamChallenges(ctx, event.ID, team.ID, false); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -81,14 +139,213 @@ func (u *EventUseCase) JoinTeam(ctx context.Context, eventID uuid.UUID, name, jo
 		}
 	}
 
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if teamMembershipLocked(event) {
+		return model.ErrTeamMembershipLocked
+	}
+
 	// join team
-	if err = u.service.JoinTeam(ctx, eventID, name, joinCode); err != nil {
+	if err = u.service.JoinTeam(ctx, eventID, name, joinCode, event.MaxTeamSize); err != nil {
 		return err
 	}
 
+	team, err := u.GetSelfTeam(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	// the first member of the team without captain becomes its captain
+	if !team.CaptainID.Valid {
+		userID, err := tools.GetCurrentUserIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = u.service.UpdateTeamCaptain(ctx, team.ID, &userID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (u *EventUseCase) RenameTeam(ctx context.Context, eventID uuid.UUID, name string) error {
+	_, team, err := u.getCaptainTeam(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	return u.service.RenameTeam(ctx, eventID, team.ID, name)
+}
+
+func (u *EventUseCase) RegenerateTeamJoinCode(ctx context.Context, eventID uuid.UUID) (string, error) {
+	_, team, err := u.getCaptainTeam(ctx, eventID)
+	if err != nil {
+		return "", err
+	}
+
+	return u.service.RegenerateTeamJoinCode(ctx, team.ID)
+}
+
+func (u *EventUseCase) TransferTeamCaptaincy(ctx context.Context, eventID, userID uuid.UUID) error {
+	_, team, err := u.getCaptainTeam(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if !isTeamMember(team, userID) {
+		return model.ErrTeamMemberNotFound
+	}
+
+	return u.service.UpdateTeamCaptain(ctx, team.ID, &userID)
+}
+
+func (u *EventUseCase) KickTeamMember(ctx context.Context, eventID, userID uuid.UUID) error {
+	event, team, err := u.getCaptainTeam(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if teamMembershipLocked(event) {
+		return model.ErrTeamMembershipLocked
+	}
+
+	// captain has to leave the team instead
+	if team.CaptainID.UUID == userID || !isTeamMember(team, userID) {
+		return model.ErrTeamMemberNotFound
+	}
+
+	if err = u.service.RemoveParticipantFromTeam(ctx, eventID, userID); err != nil {
+		return err
+	}
+
+	return u.promoteWaitlistedParticipants(ctx, event)
+}
+
+func (u *EventUseCase) LeaveTeam(ctx context.Context, eventID uuid.UUID) error {
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if event.Participation == model.IndividualParticipationType {
+		return model.ErrTeamManagementNotAllowed
+	}
+
+	if teamMembershipLocked(event) {
+		return model.ErrTeamMembershipLocked
+	}
+
+	team, err := u.GetSelfTeam(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	// administrator has no team
+	if team.ID == uuid.Nil {
+		return model.ErrTeamNotFound
+	}
+
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// captaincy passes to the member, who joined the team first, the members are ordered by the time of joining the team
+	if team.CaptainID.UUID == userID {
+		var captainID *uuid.UUID
+		for _, member := range team.Members {
+			if member.UserID != userID {
+				captainID = &member.UserID
+				break
+			}
+		}
+
+		if err = u.service.UpdateTeamCaptain(ctx, team.ID, captainID); err != nil {
+			return err
+		}
+	}
+
+	if err = u.service.RemoveParticipantFromTeam(ctx, eventID, userID); err != nil {
+		return err
+	}
+
+	if len(team.Members) == 1 {
+		if err = u.deleteEmptyTeam(ctx, eventID, team.ID); err != nil {
+			return err
+		}
+	}
+
+	return u.promoteWaitlistedParticipants(ctx, event)
+}
+
+// deleteEmptyTeam deletes the team without members, so it does not take the event place,
+// the team with the solution attempts or the cheating incidents is kept, because its deletion would remove them
+func (u *EventUseCase) deleteEmptyTeam(ctx context.Context, eventID, teamID uuid.UUID) error {
+	hasActivity, err := u.service.TeamHasActivity(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	if hasActivity {
+		return nil
+	}
+
+	return u.service.DeleteTeam(ctx, eventID, teamID)
+}
+
+// getCaptainTeam returns the event and the team of the current user, if the user is the team captain
+func (u *EventUseCase) getCaptainTeam(ctx context.Context, eventID uuid.UUID) (*model.Event, *model.Team, error) {
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// team of the individual participant is managed by the platform
+	if event.Participation == model.IndividualParticipationType {
+		return nil, nil, model.ErrTeamManagementNotAllowed
+	}
+
+	team, err := u.GetSelfTeam(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// administrator has no team
+	if team.ID == uuid.Nil {
+		return nil, nil, model.ErrTeamNotFound
+	}
+
+	userID, err := tools.GetCurrentUserIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !team.CaptainID.Valid || team.CaptainID.UUID != userID {
+		return nil, nil, model.ErrTeamCaptainRequired
+	}
+
+	return event, team, nil
+}
+
+// teamMembershipLocked returns true if the members of the competition teams can not be changed, because the competition is started
+func teamMembershipLocked(event *model.Event) bool {
+	return event.Type == model.CompetitionEventType && event.StartTime.Before(time.Now().UTC())
+}
+
+func isTeamMember(team *model.Team, userID uuid.UUID) bool {
+	for _, member := range team.Members {
+		if member.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
 func (u *EventUseCase) GetVPNConfig(ctx context.Context, eventID uuid.UUID) (string, error) {
 	// if user is administrator, return empty config
 	// get current user role
@@ -113,12 +370,21 @@ func (u *EventUseCase) GetVPNConfig(ctx context.Context, eventID uuid.UUID) (str
 		return "", err
 	}
 
+	// laboratory is not created yet or is already released
+	if !team.LaboratoryID.Valid {
+		return "", model.ErrLaboratoryNotFound
+	}
+
 	// get lab cidr by id
 	labs, err := u.service.GetLaboratories(ctx, team.LaboratoryID.UUID)
 	if err != nil {
 		return "", err
 	}
 
+	if len(labs) == 0 {
+		return "", model.ErrLaboratoryNotFound
+	}
+
 	config, err := u.service.GetParticipantVPNConfig(ctx, fmt.Sprintf("%s-%s", eventID.String(), userID.String()), labs[0].CIDR)
 	if err != nil {
 		return "", err
@@ -174,15 +440,24 @@ func (u *EventUseCase) GetSelfTeam(ctx context.Context, eventID uuid.UUID) (*mod
 			ID:           team.ID,
 			Name:         team.Name,
 			LaboratoryID: team.LaboratoryID,
+			Disqualified: team.Disqualified,
 		}, nil
 	}
 
-	// return only team name and join code
+	members, err := u.service.GetTeamMembers(ctx, eventID, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// return only team name, join code and members
 	return &model.Team{
 		ID:           team.ID,
 		Name:         team.Name,
 		JoinCode:     team.JoinCode,
 		LaboratoryID: team.LaboratoryID,
+		CaptainID:    team.CaptainID,
+		Disqualified: team.Disqualified,
+		Members:      members,
 	}, nil
 
 }
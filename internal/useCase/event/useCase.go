@@ -5,7 +5,7 @@ import (
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/cybericebox/daemon/pkg/worker"
 	"github.com/gofrs/uuid"
-	"github.com/rs/zerolog/log"
+	"sync"
 	"time"
 )
 
@@ -13,23 +13,37 @@ type (
 	EventUseCase struct {
 		service IEventService
 		worker  Worker
+
+		m           sync.Mutex
+		runningJobs map[string]bool
 	}
 
 	IEventService interface {
 		IChallengeService
+		IHintService
+		IPrerequisiteService
+		ICheatingService
 		IChallengeCategoryService
 		ISingleEventService
 		ITeamService
+		IParticipantService
+		IInvitationService
+		IWaitlistService
 		IScoreService
+		IScoreAdjustmentService
+		IJobService
 
 		GetEvents(ctx context.Context) ([]*model.Event, error)
 		CreateEvent(ctx context.Context, event *model.Event) (*model.Event, error)
 
-		CreateEventTeamsChallenges(ctx context.Context, eventID uuid.UUID) error
+		ReconcileEventChallenges(ctx context.Context, eventID uuid.UUID, redeploy bool) error
+		ReconcileEventTeamChallenges(ctx context.Context, eventID, teamID uuid.UUID, redeploy bool) error
+		ReleaseEventResources(ctx context.Context, eventID uuid.UUID) error
 	}
 
 	Worker interface {
 		AddTask(task worker.Task)
+		CancelTask(key string)
 	}
 
 	Dependencies struct {
@@ -40,8 +54,9 @@ type (
 
 func NewUseCase(deps Dependencies) *EventUseCase {
 	return &EventUseCase{
-		service: deps.Service,
-		worker:  deps.Worker,
+		service:     deps.Service,
+		worker:      deps.Worker,
+		runningJobs: make(map[string]bool),
 	}
 }
 
@@ -58,6 +73,15 @@ func (u *EventUseCase) GetEventsInfo(ctx context.Context) ([]*model.EventInfo, e
 	}
 
 	for _, event := range events {
+		// private events are listed only for the users, who have access to them
+		hasAccess, err := u.hasEventAccess(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hasAccess {
+			continue
+		}
 
 		eventsInfo = append(eventsInfo, &model.EventInfo{
 			Type:                   event.Type,
@@ -79,64 +103,45 @@ func (u *EventUseCase) GetEventsInfo(ctx context.Context) ([]*model.EventInfo, e
 }
 
 func (u *EventUseCase) CreateEvent(ctx context.Context, event *model.Event) error {
+	if event.ScoringStrategy < model.QuadraticScoringStrategy || event.ScoringStrategy > model.LinearScoringStrategy {
+		return model.ErrScoringStrategyInvalid
+	}
+
+	// scoreboard can be frozen only during the event
+	if event.ScoreboardFreezeTime != nil && (event.ScoreboardFreezeTime.Before(event.StartTime) || event.ScoreboardFreezeTime.After(event.FinishTime)) {
+		return model.ErrScoreboardFreezeInvalid
+	}
+
+	// the attempts limit is counted within the window, so it never triggers without the window
+	if event.SolutionAttemptsLimit > 0 && event.SolutionAttemptsWindow <= 0 {
+		return model.ErrSolutionAttemptsLimitInvalid
+	}
+
 	event, err := u.service.CreateEvent(ctx, event)
 	if err != nil {
 		return err
 	}
 
-	// task to create event team challenges on event start
-	u.worker.AddTask(worker.Task{
-		Do: func() {
-			if err = u.service.CreateEventTeamsChallenges(ctx, event.ID); err != nil {
-				log.Error().Err(err).Msg("failed to create event teams challenges")
-			}
-		},
-		CheckIfNeedToDo: func() (bool, *time.Time) {
-			e, err := u.service.GetEventByID(ctx, event.ID)
-			if err != nil {
-				log.Error().Err(err).Msg("failed to get event")
-				return false, nil
-			}
-
-			next := e.StartTime.Add(-time.Minute)
-
-			return e.StartTime.Add(-time.Minute).Before(time.Now().UTC()), &next
-		},
-		TimeToDo: event.StartTime.Add(-time.Minute),
-	})
+	// job to create event team challenges on event start
+	if err = u.scheduleEventJob(ctx, model.ReconcileEventChallengesJobType, event.ID, event.StartTime.Add(-time.Minute)); err != nil {
+		return err
+	}
+
+	// job to release event laboratories and vpn clients after event finish
+	if err = u.scheduleEventJob(ctx, model.ReleaseEventResourcesJobType, event.ID, laboratoriesReleaseTime(event)); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func (u *EventUseCase) CreateEventTeamsChallengesTasks(ctx context.Context) error {
-	// get all events
-	events, err := u.GetEvents(ctx)
-	if err != nil {
-		return err
-	}
+// laboratoriesReleaseTime returns the time when the event laboratories have to be released
+func laboratoriesReleaseTime(event *model.Event) time.Time {
+	releaseTime := event.FinishTime.Add(time.Duration(event.LaboratoriesGracePeriod) * time.Minute)
 
-	for _, event := range events {
-		// task to create event team challenges on event start
-		u.worker.AddTask(worker.Task{
-			Do: func() {
-				if err = u.service.CreateEventTeamsChallenges(ctx, event.ID); err != nil {
-					log.Error().Err(err).Msg("failed to create event teams challenges")
-				}
-			},
-			CheckIfNeedToDo: func() (bool, *time.Time) {
-				e, err := u.service.GetEventByID(ctx, event.ID)
-				if err != nil {
-					log.Error().Err(err).Msg("failed to get event")
-					return false, nil
-				}
-
-				next := e.StartTime.Add(-time.Minute)
-
-				return e.StartTime.Add(-time.Minute).Before(time.Now().UTC()), &next
-			},
-			TimeToDo: event.StartTime.Add(-time.Minute),
-		})
+	if event.WithdrawTime.Before(releaseTime) {
+		return event.WithdrawTime
 	}
 
-	return nil
+	return releaseTime
 }
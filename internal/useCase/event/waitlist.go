@@ -0,0 +1,129 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"github.com/cybericebox/daemon/internal/config"
+	"github.com/cybericebox/daemon/internal/model"
+	"github.com/gofrs/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type (
+	IWaitlistService interface {
+		CountEventActiveParticipants(ctx context.Context, eventID uuid.UUID) (int64, error)
+		GetEventWaitlistedParticipants(ctx context.Context, eventID uuid.UUID) ([]uuid.UUID, error)
+		CountEventTeams(ctx context.Context, eventID uuid.UUID) (int64, error)
+
+		SendWaitlistPromotionEmail(ctx context.Context, sendTo string, data model.WaitlistPromotionTemplateData) error
+	}
+)
+
+// eventPlaces returns the count of the participants, who can take part in the event, 0 is unlimited
+func eventPlaces(event *model.Event) int64 {
+	// every individual participant has own team
+	if event.Participation == model.IndividualParticipationType {
+		return int64(event.MaxTeams)
+	}
+
+	if event.MaxTeams > 0 && event.MaxTeamSize > 0 {
+		return int64(event.MaxTeams) * int64(event.MaxTeamSize)
+	}
+
+	return 0
+}
+
+// isEventFull returns true if all event places are taken by the pending and approved participants
+func (u *EventUseCase) isEventFull(ctx context.Context, event *model.Event) (bool, error) {
+	places := eventPlaces(event)
+	if places == 0 {
+		return false, nil
+	}
+
+	count, err := u.service.CountEventActiveParticipants(ctx, event.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return count >= places, nil
+}
+
+// isTeamsLimitReached returns true if the event has the maximum count of teams, so no team can be created
+func (u *EventUseCase) isTeamsLimitReached(ctx context.Context, event *model.Event) (bool, error) {
+	if event.MaxTeams <= 0 {
+		return false, nil
+	}
+
+	teamsCount, err := u.service.CountEventTeams(ctx, event.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return teamsCount >= int64(event.MaxTeams), nil
+}
+
+// promoteWaitlistedParticipants moves the waitlisted participants to the free event places in order of the registration
+func (u *EventUseCase) promoteWaitlistedParticipants(ctx context.Context, event *model.Event) error {
+	waitlisted, err := u.service.GetEventWaitlistedParticipants(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range waitlisted {
+		full, err := u.isEventFull(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		if full {
+			return nil
+		}
+
+		status := model.PendingParticipationStatus
+
+		// if registration is open, set status to approved
+		if event.Registration == model.OpenRegistrationType {
+			status = model.ApprovedParticipationStatus
+		}
+
+		// the approved individual participant gets the team, so the teams limit is checked as on the team creation,
+		// the teams kept after their members left take the places too
+		if status == model.ApprovedParticipationStatus && event.Participation == model.IndividualParticipationType {
+			limitReached, err := u.isTeamsLimitReached(ctx, event)
+			if err != nil {
+				return err
+			}
+
+			if limitReached {
+				return nil
+			}
+		}
+
+		if err = u.service.UpdateParticipantStatus(ctx, event.ID, userID, status); err != nil {
+			return err
+		}
+
+		user, err := u.service.GetUserByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// if event participation is individual, create team for user with name as user`s name
+		if status == model.ApprovedParticipationStatus && event.Participation == model.IndividualParticipationType {
+			if err = u.createParticipantTeam(ctx, event, userID, user.Name); err != nil {
+				return err
+			}
+		}
+
+		// participant is promoted even if the email is not sent
+		if err = u.service.SendWaitlistPromotionEmail(ctx, user.Email, model.WaitlistPromotionTemplateData{
+			Username:  user.Name,
+			EventName: event.Name,
+			Link:      fmt.Sprintf("%s://%s.%s", config.SchemeHTTPS, event.Tag, config.PlatformDomain),
+		}); err != nil {
+			log.Error().Err(err).Str("user", userID.String()).Msg("failed to send waitlist promotion email")
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/cybericebox/daemon/internal/model"
 	"github.com/gofrs/uuid"
+	"regexp"
 )
 
 type (
@@ -42,13 +43,39 @@ func (u *ExerciseUseCase) GetExercise(ctx context.Context, exerciseID uuid.UUID)
 }
 
 func (u *ExerciseUseCase) CreateExercise(ctx context.Context, exercise *model.Exercise) error {
+	if err := validateExerciseTasks(exercise.Data.Tasks); err != nil {
+		return err
+	}
+
 	return u.service.CreateExercise(ctx, exercise)
 }
 
 func (u *ExerciseUseCase) UpdateExercise(ctx context.Context, exercise *model.Exercise) error {
+	if err := validateExerciseTasks(exercise.Data.Tasks); err != nil {
+		return err
+	}
+
 	return u.service.UpdateExercise(ctx, exercise)
 }
 
 func (u *ExerciseUseCase) DeleteExercise(ctx context.Context, exerciseID uuid.UUID) error {
 	return u.service.DeleteExercise(ctx, exerciseID)
 }
+
+func validateExerciseTasks(tasks []model.Task) error {
+	for _, task := range tasks {
+		if task.FlagMatchMode < model.ExactFlagMatchMode || task.FlagMatchMode > model.AnyOfFlagMatchMode {
+			return model.ErrExerciseFlagMatchModeInvalid
+		}
+
+		if task.FlagMatchMode == model.RegexFlagMatchMode {
+			for _, flag := range task.Flags {
+				if _, err := regexp.Compile(flag); err != nil {
+					return model.ErrExerciseFlagPatternInvalid
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -28,6 +28,7 @@ type (
 
 	Worker interface {
 		AddTask(task worker.Task)
+		CancelTask(key string)
 	}
 
 	Dependencies struct {
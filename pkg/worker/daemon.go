@@ -1,84 +1,193 @@
 package worker
 
 import (
+	"container/heap"
+	"context"
 	"github.com/rs/zerolog/log"
-	"slices"
 	"sync"
 	"time"
 )
 
 type (
 	Worker struct {
-		m           sync.RWMutex
+		m           sync.Mutex
 		maxWorkers  int
-		inputTasks  chan Task
-		queuedTasks []Task
+		queuedTasks taskQueue
+		keyedTasks  map[string]*queuedTask
 		toDoTasks   chan Task
+
+		wake     chan struct{}
+		stop     chan struct{}
+		stopOnce sync.Once
+		stopped  chan struct{}
+		workers  sync.WaitGroup
 	}
 
 	Task struct {
-		Do func()
+		// Key identifies the task, the queued task with the same key is replaced by the new one. Empty key is not unique
+		Key string
+		Do  func()
 		// CheckIfNeedToDo returns if it needs to do now, not nil timeToDo is time to do task if not now
 		CheckIfNeedToDo func() (need bool, nextTimeToDo *time.Time)
 		TimeToDo        time.Time
 	}
+
+	queuedTask struct {
+		task  Task
+		index int
+	}
+
+	// taskQueue is a min heap of the tasks ordered by time to do
+	taskQueue []*queuedTask
 )
 
 func NewWorker(maxWorkers int) *Worker {
 	return &Worker{
 		maxWorkers:  maxWorkers,
-		inputTasks:  make(chan Task, 10),
-		toDoTasks:   make(chan Task, 200),
-		queuedTasks: make([]Task, 0),
+		queuedTasks: make(taskQueue, 0),
+		keyedTasks:  make(map[string]*queuedTask),
+		toDoTasks:   make(chan Task),
+		wake:        make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+		stopped:     make(chan struct{}),
 	}
 }
 
 func (d *Worker) Start() {
 	go d.manageTasks()
-	go d.manageToDoTasks()
-	go d.runWorkerPool()
+	d.runWorkerPool()
 }
 
-func (d *Worker) AddTask(task Task) {
-	d.inputTasks <- task
+// Stop stops dispatching of the queued tasks and waits until the running tasks are done or the context is done
+func (d *Worker) Stop(ctx context.Context) error {
+	d.stopOnce.Do(func() {
+		close(d.stop)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		<-d.stopped
+		d.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-func (d *Worker) manageTasks() {
-	for task := range d.inputTasks {
-		log.Debug().Msg("New task added")
-		if task.TimeToDo.IsZero() {
-			task.TimeToDo = time.Now()
+func (d *Worker) AddTask(task Task) {
+	if task.TimeToDo.IsZero() {
+		task.TimeToDo = time.Now()
+	}
+
+	d.m.Lock()
+	if queued, ok := d.keyedTasks[task.Key]; ok && task.Key != "" {
+		log.Debug().Str("key", task.Key).Msg("Task rescheduled")
+		queued.task = task
+		heap.Fix(&d.queuedTasks, queued.index)
+	} else {
+		log.Debug().Str("key", task.Key).Msg("New task added")
+		queued = &queuedTask{task: task}
+		heap.Push(&d.queuedTasks, queued)
+		if task.Key != "" {
+			d.keyedTasks[task.Key] = queued
 		}
-		d.m.Lock()
-		d.queuedTasks = append(d.queuedTasks, task)
+	}
+	d.m.Unlock()
 
-		slices.SortFunc(d.queuedTasks, func(i, j Task) int {
-			return i.TimeToDo.Compare(j.TimeToDo)
-		})
+	d.wakeUp()
+}
 
-		d.m.Unlock()
+// CancelTask removes the queued task with the key, the already running task is not affected
+func (d *Worker) CancelTask(key string) {
+	d.m.Lock()
+	if queued, ok := d.keyedTasks[key]; ok {
+		log.Debug().Str("key", key).Msg("Task cancelled")
+		heap.Remove(&d.queuedTasks, queued.index)
+		delete(d.keyedTasks, key)
 	}
+	d.m.Unlock()
+
+	d.wakeUp()
 }
 
-func (d *Worker) manageToDoTasks() {
+func (d *Worker) wakeUp() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// manageTasks sleeps until the first queued task has to be done and passes it to the worker pool
+func (d *Worker) manageTasks() {
+	defer func() {
+		close(d.toDoTasks)
+		close(d.stopped)
+	}()
+
 	for {
 		d.m.Lock()
-		if len(d.queuedTasks) > 0 && d.queuedTasks[0].TimeToDo.Before(time.Now()) {
-			log.Debug().Msg("Task moved to toDoTasks")
-			d.toDoTasks <- d.queuedTasks[0]
-			d.queuedTasks = d.queuedTasks[1:]
+		var next *Task
+		wait := time.Duration(-1)
+		if len(d.queuedTasks) > 0 {
+			if wait = time.Until(d.queuedTasks[0].task.TimeToDo); wait <= 0 {
+				queued := heap.Pop(&d.queuedTasks).(*queuedTask)
+				if queued.task.Key != "" {
+					delete(d.keyedTasks, queued.task.Key)
+				}
+				next = &queued.task
+			}
 		}
 		d.m.Unlock()
+
+		if next != nil {
+			log.Debug().Str("key", next.Key).Msg("Task moved to toDoTasks")
+			select {
+			case d.toDoTasks <- *next:
+			case <-d.stop:
+				return
+			}
+			continue
+		}
+
+		// there are no tasks, wait for a new one
+		if wait < 0 {
+			select {
+			case <-d.wake:
+			case <-d.stop:
+				return
+			}
+			continue
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-d.wake:
+			timer.Stop()
+		case <-d.stop:
+			timer.Stop()
+			return
+		}
 	}
 }
 
 func (d *Worker) runWorkerPool() {
 	for i := 0; i < d.maxWorkers; i++ {
+		d.workers.Add(1)
 		go func(workerID int) {
+			defer d.workers.Done()
 			for task := range d.toDoTasks {
 				log.Debug().Msgf("Worker %d started task", workerID)
 				// check if task is needed to be done
-				need, nextTimeToDo := task.CheckIfNeedToDo()
+				need, nextTimeToDo := true, (*time.Time)(nil)
+				if task.CheckIfNeedToDo != nil {
+					need, nextTimeToDo = task.CheckIfNeedToDo()
+				}
 				log.Debug().Msgf("Worker %d need: %t, nextTimeToDo: %v", workerID, need, nextTimeToDo)
 				// do task if it's needed
 				if need {
@@ -97,3 +206,33 @@ func (d *Worker) runWorkerPool() {
 		}(i + 1)
 	}
 }
+
+func (q taskQueue) Len() int {
+	return len(q)
+}
+
+func (q taskQueue) Less(i, j int) bool {
+	return q[i].task.TimeToDo.Before(q[j].task.TimeToDo)
+}
+
+func (q taskQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *taskQueue) Push(x any) {
+	queued := x.(*queuedTask)
+	queued.index = len(*q)
+	*q = append(*q, queued)
+}
+
+func (q *taskQueue) Pop() any {
+	old := *q
+	n := len(old)
+	queued := old[n-1]
+	old[n-1] = nil
+	queued.index = -1
+	*q = old[:n-1]
+	return queued
+}
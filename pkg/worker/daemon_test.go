@@ -0,0 +1,179 @@
+package worker
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTaskQueueOrder(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		offset []time.Duration
+		want   []string
+	}{
+		{
+			name:   "ordered",
+			offset: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second},
+			want:   []string{"0", "1", "2"},
+		},
+		{
+			name:   "reversed",
+			offset: []time.Duration{3 * time.Second, 2 * time.Second, time.Second},
+			want:   []string{"2", "1", "0"},
+		},
+		{
+			name:   "mixed",
+			offset: []time.Duration{2 * time.Second, -time.Second, 5 * time.Second, 0},
+			want:   []string{"1", "3", "0", "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := make(taskQueue, 0)
+			for i, offset := range tt.offset {
+				heap.Push(&q, &queuedTask{task: Task{Key: string(rune('0' + i)), TimeToDo: now.Add(offset)}})
+			}
+
+			for i, key := range tt.want {
+				queued := heap.Pop(&q).(*queuedTask)
+				if queued.task.Key != key {
+					t.Fatalf("pop %d: key = %q, want %q", i, queued.task.Key, key)
+				}
+				if queued.index != -1 {
+					t.Errorf("pop %d: index = %d, want -1", i, queued.index)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkerAddTask(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []Task
+		want  []string
+	}{
+		{
+			name:  "tasks are done by time to do",
+			tasks: []Task{{Key: "b", TimeToDo: time.Now().Add(40 * time.Millisecond)}, {Key: "a", TimeToDo: time.Now().Add(20 * time.Millisecond)}},
+			want:  []string{"a", "b"},
+		},
+		{
+			name:  "task with the same key is rescheduled",
+			tasks: []Task{{Key: "a", TimeToDo: time.Now().Add(20 * time.Millisecond)}, {Key: "b", TimeToDo: time.Now().Add(40 * time.Millisecond)}, {Key: "a", TimeToDo: time.Now().Add(60 * time.Millisecond)}},
+			want:  []string{"b", "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorker(1)
+
+			var m sync.Mutex
+			done := make([]string, 0)
+			finished := make(chan struct{}, len(tt.tasks))
+
+			for _, task := range tt.tasks {
+				key := task.Key
+				task.Do = func() {
+					m.Lock()
+					done = append(done, key)
+					m.Unlock()
+					finished <- struct{}{}
+				}
+				w.AddTask(task)
+			}
+
+			w.Start()
+			defer stopWorker(t, w)
+
+			for range tt.want {
+				select {
+				case <-finished:
+				case <-time.After(time.Second):
+					t.Fatal("task is not done")
+				}
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			if len(done) != len(tt.want) {
+				t.Fatalf("done = %v, want %v", done, tt.want)
+			}
+			for i := range tt.want {
+				if done[i] != tt.want[i] {
+					t.Fatalf("done = %v, want %v", done, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkerCancelTask(t *testing.T) {
+	w := NewWorker(1)
+
+	var m sync.Mutex
+	done := make(map[string]bool)
+	finished := make(chan struct{}, 3)
+
+	for _, key := range []string{"a", "b", "c"} {
+		w.AddTask(Task{
+			Key:      key,
+			TimeToDo: time.Now().Add(30 * time.Millisecond),
+			Do: func() {
+				m.Lock()
+				done[key] = true
+				m.Unlock()
+				finished <- struct{}{}
+			},
+		})
+	}
+
+	w.CancelTask("b")
+	// cancel of the unknown task does nothing
+	w.CancelTask("unknown")
+
+	w.Start()
+	defer stopWorker(t, w)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-finished:
+		case <-time.After(time.Second):
+			t.Fatal("task is not done")
+		}
+	}
+
+	// the cancelled task would be done at the same time as the others
+	select {
+	case <-finished:
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if !done["a"] || !done["c"] || done["b"] {
+		t.Errorf("done = %v, want a and c only", done)
+	}
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	if len(w.keyedTasks) != 0 || len(w.queuedTasks) != 0 {
+		t.Errorf("queue is not empty: %d keyed, %d queued", len(w.keyedTasks), len(w.queuedTasks))
+	}
+}
+
+func stopWorker(t *testing.T, w *Worker) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := w.Stop(ctx); err != nil {
+		t.Errorf("Stop() error = %v", err)
+	}
+}